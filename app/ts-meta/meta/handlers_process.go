@@ -149,6 +149,10 @@ func (h *Execute) Process() (transport.Codec, error) {
 		rsp.Err = err.Error()
 		return rsp, nil
 	}
+	if body, err = stampCommand(cmd, body, time.Now()); err != nil {
+		rsp.Err = err.Error()
+		return rsp, nil
+	}
 
 	if config.GetHaEnable() && cmd.GetType() == proto2.Command_CreateDatabaseCommand {
 		err = createDatabase(cmd)
@@ -233,7 +233,7 @@ func (fsm *storeFSM) applyUpdateSchemaCommand(cmd *proto2.Command) interface{} {
 	if !ok {
 		panic(fmt.Errorf("%s is not a UpdateSchemaCommand", ext))
 	}
-	return fsm.data.UpdateSchema(v.GetDatabase(), v.GetRpName(), v.GetMeasurement(), v.GetFieldToCreate())
+	return fsm.data.UpdateSchema(v.GetDatabase(), v.GetRpName(), v.GetMeasurement(), v.GetFieldToCreate(), v.GetUpdatedAt())
 }
 
 func (fsm *storeFSM) applyAlterShardKeyCommand(cmd *proto2.Command) interface{} {
@@ -18,6 +18,7 @@ package meta
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
@@ -32,3 +33,25 @@ func validateCommand(b []byte) (*proto2.Command, error) {
 
 	return cmd, nil
 }
+
+// stampCommand sets the time of the leader on the commands whose apply records it, so that every
+// node applies the same value. b, the encoded cmd, is returned re-encoded if cmd is changed
+func stampCommand(cmd *proto2.Command, b []byte, now time.Time) ([]byte, error) {
+	if cmd.GetType() != proto2.Command_UpdateSchemaCommand {
+		return b, nil
+	}
+
+	ext, err := proto.GetExtension(cmd, proto2.E_UpdateSchemaCommand_Command)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := ext.(*proto2.UpdateSchemaCommand)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a UpdateSchemaCommand", ext)
+	}
+	v.UpdatedAt = proto.Int64(now.UnixNano())
+	if err = proto.SetExtension(cmd, proto2.E_UpdateSchemaCommand_Command, v); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(cmd)
+}
@@ -17,12 +17,17 @@ limitations under the License.
 package ingestserver
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/openGemini/openGemini/app"
@@ -49,6 +54,13 @@ import (
 	"go.uber.org/zap"
 )
 
+const queryDrainCheckInterval = 10 * time.Millisecond
+
+var (
+	metaConnectInitialBackoff = 100 * time.Millisecond
+	metaConnectMaxBackoff     = 10 * time.Second
+)
+
 // Server represents a container for the metadata and storage data and services.
 // It is built using a Config and it manages the startup and shutdown of all
 // services in the proper order.
@@ -56,14 +68,15 @@ type Server struct {
 	cmd              *cobra.Command
 	Listener         net.Listener
 	initMetaClientFn func() error
+	connectMetaFn    func() error
 	MetaClient       *meta.Client
 	TSDBStore        netstorage.Storage
 	Logger           *Logger.Logger
 
-	statisticsPusher *statisticsPusher.StatisticsPusher
-	QueryExecutor    *query.Executor
-	PointsWriter     *coordinator.PointsWriter
-	httpService      *httpd.Service
+	statsPusher   atomic.Value // *statisticsPusher.StatisticsPusher, replaced by Reload
+	QueryExecutor *query.Executor
+	PointsWriter  *coordinator.PointsWriter
+	httpService   *httpd.Service
 
 	// joinPeers are the metaservers specified at run time to join this server to
 	metaJoinPeers []string
@@ -71,11 +84,20 @@ type Server struct {
 	// metaUseTLS specifies if we should use a TLS connection to the meta servers
 	metaUseTLS bool
 
-	config *config.TSSql
+	config   *config.TSSql // replaced by Reload as a whole, read it through conf
+	configMu sync.RWMutex
 
 	castorService *castor.Service
 
 	sherlockService *sherlock.Service
+
+	opened int32 // set to 1 once Open succeeds, the meta client is connected then
+	ready  int32 // set to 1 once the first meta snapshot is loaded
+
+	// metaSyncedFn returns true once the meta client has loaded the first meta snapshot
+	metaSyncedFn func() bool
+
+	reloadMu sync.Mutex
 }
 
 // updateTLSConfig stores with into the tls config pointed at by into but only if with is not nil
@@ -116,6 +138,15 @@ func NewServer(conf config.Config, cmd *cobra.Command, logger *Logger.Logger) (a
 		config:        c,
 	}
 	s.initMetaClientFn = s.initializeMetaClient
+	if c.ListenerTLS.Enabled() {
+		listenerTLS, err := c.ListenerTLS.NewTLSConfig(tlsConfig)
+		if err != nil {
+			return nil, fmt.Errorf("listener tls configuration: %v", err)
+		}
+		s.httpService.SetListenerTLS(listenerTLS)
+	}
+	s.connectMetaFn = s.connectMetaClient
+	s.metaSyncedFn = s.metaSynced
 
 	go openServer(c, logger)
 
@@ -159,17 +190,24 @@ func NewServer(conf config.Config, cmd *cobra.Command, logger *Logger.Logger) (a
 	s.httpService.Handler.QueryExecutor = s.QueryExecutor
 	s.httpService.Handler.ExtSysCtrl = s.TSDBStore
 
+	s.initStatistics()
 	s.initStatisticsPusher()
-	s.httpService.Handler.StatisticsPusher = s.statisticsPusher
+	s.initStatsHandler()
+	s.initReadyHandler()
 	syscontrol.SetQueryParallel(int64(c.HTTP.ChunkReaderParallel))
 	executor.SetPipelineExecutorResourceManagerParas(int64(c.Common.MemoryLimitSize), time.Duration(c.Common.MemoryWaitTime))
 	executor.IgnoreEmptyTag = c.Common.IgnoreEmptyTag
 
 	machine.InitMachineID(c.HTTP.BindAddress)
 
-	s.castorService = castor.NewService(c.Analysis)
-	s.sherlockService = sherlock.NewService(c.Sherlock)
-	s.sherlockService.WithLogger(s.Logger)
+	// castor and sherlock are optional background services, they are not created if disabled
+	if c.Analysis.Enabled {
+		s.castorService = castor.NewService(c.Analysis)
+	}
+	if c.Sherlock != nil && c.Sherlock.SherlockEnable {
+		s.sherlockService = sherlock.NewService(c.Sherlock)
+		s.sherlockService.WithLogger(s.Logger)
+	}
 	return s, nil
 }
 
@@ -203,41 +241,228 @@ func (s *Server) Open() error {
 	fmt.Printf("%v TSSQL starting\n", time.Now())
 
 	// if the ForceBroadcastQuery with config is true, then the ForceBroadcastQuery in memory set to 1
-	if s.config.Coordinator.ForceBroadcastQuery {
+	if s.conf().Coordinator.ForceBroadcastQuery {
 		executor.SetEnableForceBroadcastQuery(int64(1))
 	}
 
+	if err := startStages(s.openStages()); err != nil {
+		return err
+	}
+	atomic.StoreInt32(&s.opened, 1)
+	return nil
+}
+
+// serverStage is a subsystem of the server, the stages are started in order by Open
+type serverStage struct {
+	name  string
+	start func() error
+	stop  func()
+}
+
+// startStages starts the stages in order. If one of them fails, the stages
+// already started are stopped in reverse order and the error names the failed stage
+func startStages(stages []serverStage) error {
+	for i := range stages {
+		err := stages[i].start()
+		if err == nil {
+			continue
+		}
+
+		for j := i - 1; j >= 0; j-- {
+			stages[j].stop()
+		}
+		return fmt.Errorf("failed to start %s: %w", stages[i].name, err)
+	}
+	return nil
+}
+
+func (s *Server) openStages() []serverStage {
+	stages := []serverStage{{
+		name:  "meta client",
+		start: s.openMetaClient,
+		stop: func() {
+			atomic.StoreInt32(&s.ready, 0)
+			util.MustClose(s.MetaClient)
+		},
+	}, {
+		name:  "http service",
+		start: s.openHTTPService,
+		stop: func() {
+			util.MustClose(s.httpService)
+		},
+	}}
+
+	if s.castorService != nil {
+		stages = append(stages, serverStage{
+			name:  "castor service",
+			start: s.castorService.Open,
+			stop: func() {
+				util.MustClose(s.castorService)
+			},
+		})
+	}
+	if s.sherlockService != nil {
+		stages = append(stages, serverStage{
+			name: "sherlock service",
+			start: func() error {
+				s.sherlockService.Open()
+				return nil
+			},
+			stop: s.sherlockService.Stop,
+		})
+	}
+	return stages
+}
+
+func (s *Server) openMetaClient() error {
 	if err := s.initMetaClientFn(); err != nil {
 		return err
 	}
+	// the server is not ready if the meta client is closed before the first snapshot is loaded
+	if s.metaSyncedFn() {
+		atomic.StoreInt32(&s.ready, 1)
+	}
 
 	s.PointsWriter.MetaClient = s.MetaClient
 	s.httpService.Handler.MetaClient = s.MetaClient
+	return nil
+}
 
+func (s *Server) openHTTPService() error {
 	if err := s.httpService.Open(); err != nil {
 		return err
 	}
 
 	s.httpService.Handler.QueryExecutor.PointsWriter = s.PointsWriter
 	s.httpService.Handler.PointsWriter = s.PointsWriter
+	return nil
+}
+
+// Ready returns nil if the server is open and all critical subsystems are
+// initialized, so that it can serve queries
+func (s *Server) Ready() error {
+	switch {
+	case s.MetaClient == nil:
+		return errors.New("meta client is not initialized")
+	case s.TSDBStore == nil:
+		return errors.New("storage is not initialized")
+	case s.QueryExecutor == nil:
+		return errors.New("query executor is not initialized")
+	case s.httpService == nil:
+		return errors.New("http service is not initialized")
+	case atomic.LoadInt32(&s.opened) == 0:
+		return errors.New("server is not open, the meta client is not connected")
+	}
+	return nil
+}
+
+// IsReady returns true once the meta client has loaded the first meta snapshot,
+// queries before that may fail with errors like "measurement not found"
+func (s *Server) IsReady() bool {
+	return atomic.LoadInt32(&s.ready) == 1
+}
 
-	if err := s.castorService.Open(); err != nil {
+// Reload applies the reloadable settings of conf to the running server without
+// dropping connections, they are the monitor settings and the log level.
+// Changes to the settings which require a restart, like the listen address, are rejected
+func (s *Server) Reload(conf *config.TSSql) error {
+	if conf == nil {
+		return errors.New("config to reload is nil")
+	}
+	if err := conf.Validate(); err != nil {
 		return err
 	}
-	if s.sherlockService != nil {
-		s.sherlockService.Open()
+
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	cur := s.conf()
+	if conf.HTTP.BindAddress != cur.HTTP.BindAddress {
+		return fmt.Errorf("http bind-address cannot be reloaded, restart the server to change it from %s to %s",
+			cur.HTTP.BindAddress, conf.HTTP.BindAddress)
+	}
+	if strings.Join(conf.Common.MetaJoin, ",") != strings.Join(cur.Common.MetaJoin, ",") {
+		return errors.New("common meta-join cannot be reloaded, restart the server to change it")
+	}
+
+	// the settings are applied to a copy, which replaces the config once it is complete
+	next := *cur
+	if conf.Logging.Level != cur.Logging.Level {
+		Logger.SetLevel(conf.Logging.Level)
+		s.Logger.Info("log level reloaded",
+			zap.Stringer("from", cur.Logging.Level), zap.Stringer("to", conf.Logging.Level))
+		next.Logging.Level = conf.Logging.Level
+	}
+
+	monitor := conf.Monitor
+	monitor.SetApp(cur.Monitor.GetApp())
+	var sp *statisticsPusher.StatisticsPusher
+	monitorChanged := monitor != cur.Monitor
+	if monitorChanged {
+		if prev := s.swapStatisticsPusher(nil); prev != nil {
+			prev.Stop()
+		}
+		next.Monitor = monitor
+		if monitor.StoreEnabled {
+			sp = s.startStatisticsPusher(&next, statisticsPusher.ReloadStatisticsPusher)
+		}
+	}
+
+	s.configMu.Lock()
+	s.config = &next
+	s.configMu.Unlock()
+
+	if monitorChanged {
+		s.swapStatisticsPusher(sp)
+		s.Logger.Info("monitor settings reloaded",
+			zap.String("pushers", monitor.Pushers), zap.Bool("store-enabled", monitor.StoreEnabled))
 	}
 	return nil
 }
 
+// conf returns the current config of the server, it must not be changed since Reload replaces it as a whole
+func (s *Server) conf() *config.TSSql {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+	return s.config
+}
+
+// Close stops accepting new connections and waits up to the configured
+// shutdown-timeout for the running queries before closing the server.
+// The queries still running after that are aborted and reported in the returned error.
 func (s *Server) Close() error {
-	if s.statisticsPusher != nil {
-		s.statisticsPusher.Stop()
+	var timeout time.Duration
+	if conf := s.conf(); conf != nil {
+		timeout = time.Duration(conf.Coordinator.ShutdownTimeout)
 	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return s.Shutdown(ctx)
+}
+
+// Shutdown stops accepting new connections, waits for the running queries to
+// finish or ctx to be done, and then closes the server. The queries still
+// running when ctx is done are aborted and reported in the returned error.
+func (s *Server) Shutdown(ctx context.Context) error {
+	atomic.StoreInt32(&s.opened, 0)
+	atomic.StoreInt32(&s.ready, 0)
 
 	// Close the listener first to stop any new connections
 	if s.Listener != nil {
 		util.MustClose(s.Listener)
+		s.Listener = nil
+	}
+	if s.httpService != nil {
+		if err := s.httpService.CloseListeners(); err != nil {
+			s.Logger.Error("failed to close http listeners", zap.Error(err))
+		}
+	}
+
+	err := s.drainQueries(ctx)
+
+	if sp := s.StatisticsPusher(); sp != nil {
+		sp.Stop()
 	}
 
 	if s.httpService != nil {
@@ -256,28 +481,171 @@ func (s *Server) Close() error {
 		s.PointsWriter.Close()
 	}
 
+	if s.castorService != nil {
+		util.MustClose(s.castorService)
+	}
+
 	if s.sherlockService != nil {
 		s.sherlockService.Stop()
 	}
-	return nil
+	return err
+}
+
+// drainQueries waits until there is no running query or ctx is done,
+// the queries still running then are aborted
+func (s *Server) drainQueries(ctx context.Context) error {
+	if s.QueryExecutor == nil {
+		return nil
+	}
+
+	tm := s.QueryExecutor.TaskManager
+	ticker := time.NewTicker(queryDrainCheckInterval)
+	defer ticker.Stop()
+	for {
+		queries := tm.Queries()
+		if len(queries) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			aborted := make([]string, 0, len(queries))
+			for _, q := range queries {
+				if err := tm.KillQuery(q.ID); err != nil {
+					continue
+				}
+				aborted = append(aborted, fmt.Sprintf("%d: %s", q.ID, q.Query))
+			}
+			return fmt.Errorf("%w: %d queries aborted on shutdown: [%s]",
+				ctx.Err(), len(aborted), strings.Join(aborted, ", "))
+		case <-ticker.C:
+		}
+	}
 }
 
 func (s *Server) Err() <-chan error { return nil }
 
+// initializeMetaClient connects to the meta servers, retrying with exponential
+// backoff up to the configured meta-connect-retries times
 func (s *Server) initializeMetaClient() error {
 	if len(s.metaJoinPeers) == 0 {
 		// start up a new single node cluster
 		return fmt.Errorf("server not set to join existing cluster must run also as a meta node")
-	} else {
-		_, _, err := s.MetaClient.InitMetaClient(s.metaJoinPeers, s.metaUseTLS, nil)
-		if err != nil {
-			return err
+	}
+
+	retries := config.DefaultMetaConnectRetries
+	if conf := s.conf(); conf != nil {
+		retries = conf.Coordinator.MetaConnectRetries
+	}
+
+	backoff := metaConnectInitialBackoff
+	for attempt := 1; ; attempt++ {
+		s.Logger.Info("connecting to meta servers",
+			zap.Strings("peers", s.metaJoinPeers), zap.Int("attempt", attempt))
+		err := s.connectMetaFn()
+		if err == nil {
+			return nil
 		}
-		err = s.MetaClient.Open()
+
+		s.Logger.Warn("failed to connect to meta servers",
+			zap.Strings("peers", s.metaJoinPeers), zap.Int("attempt", attempt), zap.Error(err))
+		if attempt > retries {
+			return fmt.Errorf("failed to connect to meta servers %v after %d attempts: %w",
+				s.metaJoinPeers, attempt, err)
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > metaConnectMaxBackoff {
+			backoff = metaConnectMaxBackoff
+		}
+	}
+}
+
+// metaSynced returns true once the meta client has loaded the first meta snapshot
+func (s *Server) metaSynced() bool {
+	return s.MetaClient != nil && s.MetaClient.SnapshotLoaded()
+}
+
+func (s *Server) connectMetaClient() error {
+	_, _, err := s.MetaClient.InitMetaClient(s.metaJoinPeers, s.metaUseTLS, nil)
+	if err != nil {
+		return err
+	}
+	return s.MetaClient.Open()
+}
+
+func (s *Server) initStatsHandler() {
+	if !s.config.HTTP.StatsEnabled {
+		return
+	}
+
+	s.httpService.Handler.AddRoutes(httpd.Route{
+		Name:        "stats",
+		Method:      http.MethodGet,
+		Pattern:     s.config.HTTP.StatsPath,
+		HandlerFunc: s.serveStats,
+	})
+}
+
+func (s *Server) initReadyHandler() {
+	s.httpService.Handler.AddRoutes(httpd.Route{
+		Name:           "ready",
+		Method:         http.MethodGet,
+		Pattern:        "/ready",
+		LoggingEnabled: true,
+		HandlerFunc:    s.serveReady,
+	}, httpd.Route{
+		Name:           "ready-head",
+		Method:         http.MethodHead,
+		Pattern:        "/ready",
+		LoggingEnabled: true,
+		HandlerFunc:    s.serveReady,
+	})
+}
+
+// serveReady responds with 204 once the server is ready to serve queries, otherwise with 503
+func (s *Server) serveReady(w http.ResponseWriter, r *http.Request) {
+	if !s.IsReady() {
+		http.Error(w, "meta data is not synchronized", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveStats serves the runtime statistics of the server in JSON,
+// including the statistics sent by the statistics pusher if it is enabled
+func (s *Server) serveStats(w http.ResponseWriter, r *http.Request) {
+	conf := s.conf()
+	stats := map[string]interface{}{
+		"connections": map[string]int64{
+			"active": atomic.LoadInt64(&stat.HandlerStat.ActiveRequests),
+		},
+		"castor": map[string]bool{
+			"enabled": conf.Analysis.Enabled,
+		},
+		"sherlock": map[string]bool{
+			"enabled": conf.Sherlock != nil && conf.Sherlock.SherlockEnable,
+		},
+	}
+	if s.QueryExecutor != nil {
+		stats["queries"] = map[string]int{
+			"running": len(s.QueryExecutor.TaskManager.Queries()),
+		}
+	}
+
+	if sp := s.StatisticsPusher(); sp != nil {
+		statistics, err := sp.CollectOpsStatistics()
 		if err != nil {
-			return err
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
 		}
-		return nil
+		stats["statistics"] = statistics
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		s.Logger.Error("failed to write stats response", zap.Error(err))
 	}
 }
 
@@ -291,18 +659,36 @@ func (s *Server) initStatisticsPusher() {
 	if !s.config.Monitor.StoreEnabled {
 		return
 	}
+	s.swapStatisticsPusher(s.startStatisticsPusher(s.config, statisticsPusher.NewStatisticsPusher))
+}
+
+// StatisticsPusher returns the running statistics pusher, nil if the statistics are not pushed
+func (s *Server) StatisticsPusher() *statisticsPusher.StatisticsPusher {
+	sp, _ := s.statsPusher.Load().(*statisticsPusher.StatisticsPusher)
+	return sp
+}
+
+// swapStatisticsPusher makes sp the statistics pusher of the server and the http handler,
+// and returns the previous one
+func (s *Server) swapStatisticsPusher(sp *statisticsPusher.StatisticsPusher) *statisticsPusher.StatisticsPusher {
+	prev := s.StatisticsPusher()
+	s.statsPusher.Store(sp)
+	if s.httpService != nil {
+		s.httpService.Handler.SetStatisticsPusher(sp)
+	}
+	return prev
+}
 
+// initStatistics initializes the statistics collected by the statistics pusher.
+// They are shared by the whole server, so they are initialized once before serving,
+// even if the pusher is disabled, and are kept by the pushers started by Reload
+func (s *Server) initStatistics() {
 	appName := "ts-sql"
 	if app.IsSingle() {
 		appName = "ts-server"
 		s.config.Monitor.SetApp(config.AppSingle)
 	}
 
-	s.statisticsPusher = statisticsPusher.NewStatisticsPusher(&s.config.Monitor, s.Logger)
-	if s.statisticsPusher == nil {
-		return
-	}
-
 	globalTags := map[string]string{
 		"hostname": s.config.HTTP.BindAddress,
 		"app":      appName,
@@ -312,12 +698,21 @@ func (s *Server) initStatisticsPusher() {
 	stat.InitSpdyStatistics(globalTags)
 	transport.InitStatistics(transport.AppSql)
 	stat.InitSlowQueryStatistics(globalTags)
-	stat.InitRuntimeStatistics(globalTags, int(time.Duration(s.config.Monitor.StoreInterval).Seconds()))
+	stat.InitRuntimeStatistics(globalTags, int(s.config.Monitor.GetPushInterval().Seconds()))
 	stat.NewMetaStatistics().Init(globalTags)
 	stat.InitExecutorStatistics(globalTags)
 	stat.NewErrnoStat().Init(globalTags)
+}
+
+// startStatisticsPusher creates a statistics pusher of conf with newPusher and starts it,
+// nil is returned if no pusher is configured. conf must not be shared with readers yet
+func (s *Server) startStatisticsPusher(conf *config.TSSql, newPusher func(*config.Monitor, *Logger.Logger) *statisticsPusher.StatisticsPusher) *statisticsPusher.StatisticsPusher {
+	sp := newPusher(&conf.Monitor, s.Logger)
+	if sp == nil {
+		return nil
+	}
 
-	s.statisticsPusher.Register(
+	sp.Register(
 		stat.CollectHandlerStatistics,
 		stat.CollectSpdyStatistics,
 		stat.CollectSqlSlowQueryStatistics,
@@ -326,12 +721,13 @@ func (s *Server) initStatisticsPusher() {
 		stat.NewErrnoStat().Collect,
 	)
 
-	s.statisticsPusher.RegisterOps(stat.CollectOpsHandlerStatistics)
-	s.statisticsPusher.RegisterOps(stat.CollectOpsSpdyStatistics)
-	s.statisticsPusher.RegisterOps(stat.CollectOpsSqlSlowQueryStatistics)
-	s.statisticsPusher.RegisterOps(stat.CollectOpsRuntimeStatistics)
-	s.statisticsPusher.RegisterOps(stat.CollectExecutorStatisticsOps)
-	s.statisticsPusher.RegisterOps(stat.NewErrnoStat().CollectOps)
+	sp.RegisterOps(stat.CollectOpsHandlerStatistics)
+	sp.RegisterOps(stat.CollectOpsSpdyStatistics)
+	sp.RegisterOps(stat.CollectOpsSqlSlowQueryStatistics)
+	sp.RegisterOps(stat.CollectOpsRuntimeStatistics)
+	sp.RegisterOps(stat.CollectExecutorStatisticsOps)
+	sp.RegisterOps(stat.NewErrnoStat().CollectOps)
 
-	s.statisticsPusher.Start()
+	sp.Start()
+	return sp
 }
@@ -17,20 +17,40 @@ limitations under the License.
 package ingestserver
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/app"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	"github.com/openGemini/openGemini/open_src/influx/query"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 )
 
 func Test_NewServer(t *testing.T) {
@@ -49,8 +69,44 @@ func Test_NewServer(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, server.(*Server).MetaClient)
 	require.NotNil(t, server.(*Server).TSDBStore)
-	require.NotNil(t, server.(*Server).castorService)
-	require.NotNil(t, server.(*Server).sherlockService)
+	require.Nil(t, server.(*Server).castorService)
+	require.Nil(t, server.(*Server).sherlockService)
+}
+
+func Test_NewServer_OptionalServices(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		ValidArgs: []string{"dev", "abcd", "now"},
+		Version:   "Version",
+	}
+
+	for _, tt := range []struct {
+		castor   bool
+		sherlock bool
+	}{
+		{castor: false, sherlock: false},
+		{castor: true, sherlock: false},
+		{castor: false, sherlock: true},
+		{castor: true, sherlock: true},
+	} {
+		conf := config.NewTSSql()
+		conf.Common.ReportEnable = false
+		conf.Analysis.Enabled = tt.castor
+		conf.Sherlock.SherlockEnable = tt.sherlock
+		conf.Sherlock.DumpPath = path.Join(t.TempDir(), "sherlock")
+
+		server, err := NewServer(conf, cmd, log)
+		require.NoError(t, err)
+		s := server.(*Server)
+		require.Equal(t, tt.castor, s.castorService != nil)
+		require.Equal(t, tt.sherlock, s.sherlockService != nil)
+
+		s.initMetaClientFn = func() error {
+			return nil
+		}
+		require.NoError(t, s.Open())
+		require.NoError(t, s.Close())
+	}
 }
 
 func Test_NewServer_Open_Close(t *testing.T) {
@@ -65,6 +121,7 @@ func Test_NewServer_Open_Close(t *testing.T) {
 	conf := config.NewTSSql()
 	conf.Common.MetaJoin = append(conf.Common.MetaJoin, []string{"127.0.0.1:9179"}...)
 	conf.Common.ReportEnable = false
+	conf.Sherlock.SherlockEnable = true
 	conf.Sherlock.DumpPath = path.Join(tmpDir, "sherlock")
 
 	server, err := NewServer(conf, cmd, log)
@@ -81,6 +138,204 @@ func Test_NewServer_Open_Close(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestServer_Ready(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		ValidArgs: []string{"dev", "abcd", "now"},
+		Version:   "Version",
+	}
+
+	require.EqualError(t, (&Server{}).Ready(), "meta client is not initialized")
+
+	conf := config.NewTSSql()
+	conf.Common.ReportEnable = false
+	conf.Sherlock.DumpPath = path.Join(t.TempDir(), "sherlock")
+	server, err := NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	s := server.(*Server)
+	require.EqualError(t, s.Ready(), "server is not open, the meta client is not connected")
+
+	connected := false
+	s.initMetaClientFn = func() error {
+		if !connected {
+			return errors.New("meta is unavailable")
+		}
+		return nil
+	}
+	require.Error(t, s.Open())
+	require.Error(t, s.Ready())
+
+	connected = true
+	require.NoError(t, s.Open())
+	require.NoError(t, s.Ready())
+
+	require.NoError(t, s.Close())
+	require.Error(t, s.Ready())
+}
+
+func TestStartStages(t *testing.T) {
+	var events []string
+	stage := func(name string, err error) serverStage {
+		return serverStage{
+			name: name,
+			start: func() error {
+				events = append(events, "start "+name)
+				return err
+			},
+			stop: func() {
+				events = append(events, "stop "+name)
+			},
+		}
+	}
+
+	err := startStages([]serverStage{
+		stage("a", nil),
+		stage("b", nil),
+		stage("c", errors.New("c is unavailable")),
+		stage("d", nil),
+	})
+	require.EqualError(t, err, "failed to start c: c is unavailable")
+	require.Equal(t, []string{"start a", "start b", "start c", "stop b", "stop a"}, events)
+
+	events = events[:0]
+	require.NoError(t, startStages([]serverStage{stage("a", nil), stage("b", nil)}))
+	require.Equal(t, []string{"start a", "start b"}, events)
+}
+
+func TestServer_OpenStageFailure(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		ValidArgs: []string{"dev", "abcd", "now"},
+		Version:   "Version",
+	}
+
+	// the http service fails to listen on the address in use
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	conf := config.NewTSSql()
+	conf.Common.ReportEnable = false
+	conf.HTTP.BindAddress = ln.Addr().String()
+	conf.Sherlock.DumpPath = path.Join(t.TempDir(), "sherlock")
+	server, err := NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	s := server.(*Server)
+	s.initMetaClientFn = func() error {
+		return nil
+	}
+
+	err = s.Open()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to start http service")
+	// the meta client started before is stopped
+	require.False(t, s.IsReady())
+	require.Error(t, s.Ready())
+}
+
+func TestServer_IsReady(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		ValidArgs: []string{"dev", "abcd", "now"},
+		Version:   "Version",
+	}
+
+	conf := config.NewTSSql()
+	conf.Common.ReportEnable = false
+	conf.Sherlock.DumpPath = path.Join(t.TempDir(), "sherlock")
+	server, err := NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	s := server.(*Server)
+
+	serveReady := func() int {
+		rec := httptest.NewRecorder()
+		s.httpService.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+		return rec.Code
+	}
+	require.False(t, s.IsReady())
+	require.Equal(t, http.StatusServiceUnavailable, serveReady())
+
+	synced := false
+	s.initMetaClientFn = func() error {
+		if !synced {
+			return errors.New("meta is unavailable")
+		}
+		return nil
+	}
+	require.Error(t, s.Open())
+	require.False(t, s.IsReady())
+	require.Equal(t, http.StatusServiceUnavailable, serveReady())
+
+	s.metaSyncedFn = func() bool {
+		return synced
+	}
+	synced = true
+	require.NoError(t, s.Open())
+	require.True(t, s.IsReady())
+	require.Equal(t, http.StatusNoContent, serveReady())
+
+	require.NoError(t, s.Close())
+	require.False(t, s.IsReady())
+}
+
+func TestServer_IsReady_NoSnapshot(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		ValidArgs: []string{"dev", "abcd", "now"},
+		Version:   "Version",
+	}
+
+	conf := config.NewTSSql()
+	conf.Common.ReportEnable = false
+	conf.Sherlock.DumpPath = path.Join(t.TempDir(), "sherlock")
+	server, err := NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	s := server.(*Server)
+
+	// the meta client is connected, but it has not loaded a snapshot
+	s.initMetaClientFn = func() error {
+		return nil
+	}
+	require.NoError(t, s.Open())
+	require.NoError(t, s.Ready())
+	require.False(t, s.IsReady())
+	require.NoError(t, s.Close())
+}
+
+func TestServer_InitMetaClientRetry(t *testing.T) {
+	backoff := metaConnectInitialBackoff
+	metaConnectInitialBackoff = time.Millisecond
+	defer func() {
+		metaConnectInitialBackoff = backoff
+	}()
+
+	server := &Server{}
+	server.Logger = logger.NewLogger(errno.ModuleUnknown)
+	server.config = config.NewTSSql()
+	server.metaJoinPeers = []string{"127.0.0.1:8092"}
+
+	attempts := 0
+	server.connectMetaFn = func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("meta is unavailable")
+		}
+		return nil
+	}
+	require.NoError(t, server.initializeMetaClient())
+	require.Equal(t, 3, attempts)
+
+	attempts = 0
+	server.config.Coordinator.MetaConnectRetries = 1
+	server.connectMetaFn = func() error {
+		attempts++
+		return errors.New("meta is unavailable")
+	}
+	err := server.initializeMetaClient()
+	require.EqualError(t, err, "failed to connect to meta servers [127.0.0.1:8092] after 2 attempts: meta is unavailable")
+	require.Equal(t, 2, attempts)
+}
+
 func TestServer_Close(t *testing.T) {
 	var err error
 	server := Server{}
@@ -96,15 +351,291 @@ func TestServer_Close(t *testing.T) {
 	assert.NoError(t, server.Close())
 }
 
+func generateCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := path.Join(dir, "server.crt"), path.Join(dir, "server.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+func TestServer_ListenTLS(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		Version: "Version",
+	}
+
+	tmpDir := t.TempDir()
+	conf := config.NewTSSql()
+	conf.Common.ReportEnable = false
+	conf.Sherlock.DumpPath = path.Join(tmpDir, "sherlock")
+
+	// plaintext if the certificate is not set
+	server, err := NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	svc := server.(*Server).httpService
+	require.NoError(t, svc.Openlistener("127.0.0.1:0"))
+	_, ok := svc.Ln[0].(*net.TCPListener)
+	require.True(t, ok)
+	require.NoError(t, svc.CloseListeners())
+
+	conf.ListenerTLS.Certificate, conf.ListenerTLS.PrivateKey = generateCertificate(t, tmpDir)
+	conf.TLS.MinVersion = "TLS1.3"
+	require.NoError(t, conf.Validate())
+	server, err = NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	svc = server.(*Server).httpService
+	require.NoError(t, svc.Openlistener("127.0.0.1:0"))
+	ln := svc.Ln[0]
+	defer ln.Close()
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	// #nosec
+	conn, err := tls.Dial("tcp", ln.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+	require.NoError(t, err)
+	require.Equal(t, uint16(tls.VersionTLS13), conn.ConnectionState().Version)
+	require.NoError(t, conn.Close())
+
+	// #nosec
+	_, err = tls.Dial("tcp", ln.Addr().String(), &tls.Config{InsecureSkipVerify: true, MaxVersion: tls.VersionTLS12})
+	require.Error(t, err)
+}
+
 func TestInitStatisticsPusher(t *testing.T) {
 	server := &Server{}
 	server.Logger = logger.NewLogger(errno.ModuleUnknown)
 	server.config = config.NewTSSql()
 	server.config.Monitor.Pushers = "http"
 	server.config.Monitor.StoreEnabled = true
+	server.config.Monitor.PushInterval = toml.Duration(time.Second)
+	assert.NoError(t, server.config.Validate())
 
 	app.SwitchToSingle()
 	server.initStatisticsPusher()
+	assert.Equal(t, time.Second, server.StatisticsPusher().PushInterval())
 	time.Sleep(10 * time.Millisecond)
 	server.Close()
 }
+
+func TestServer_Reload(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		Version: "Version",
+	}
+
+	conf := config.NewTSSql()
+	conf.Common.ReportEnable = false
+	conf.Sherlock.DumpPath = path.Join(t.TempDir(), "sherlock")
+	server, err := NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	s := server.(*Server)
+	require.Nil(t, s.StatisticsPusher())
+
+	newConf := *conf
+	newConf.Monitor.Pushers = "http"
+	newConf.Monitor.StoreEnabled = true
+	newConf.Monitor.PushInterval = toml.Duration(2 * time.Second)
+	require.NoError(t, s.Reload(&newConf))
+	require.NotNil(t, s.StatisticsPusher())
+	require.Equal(t, 2*time.Second, s.StatisticsPusher().PushInterval())
+	require.Equal(t, s.StatisticsPusher(), s.httpService.Handler.StatisticsPusher())
+
+	pusher := s.StatisticsPusher()
+	require.NoError(t, s.Reload(&newConf))
+	require.Equal(t, pusher, s.StatisticsPusher())
+
+	newConf.Monitor.PushInterval = toml.Duration(time.Second)
+	require.NoError(t, s.Reload(&newConf))
+	require.Equal(t, time.Second, s.StatisticsPusher().PushInterval())
+
+	level := logger.GetLevel()
+	defer logger.SetLevel(level)
+	newConf.Logging.Level = zapcore.DebugLevel
+	require.NoError(t, s.Reload(&newConf))
+	require.Equal(t, zapcore.DebugLevel, logger.GetLevel())
+
+	newConf.HTTP.BindAddress = "127.0.0.1:18086"
+	require.Error(t, s.Reload(&newConf))
+	require.Error(t, s.Reload(nil))
+
+	s.StatisticsPusher().Stop()
+}
+
+func TestServer_ReloadWhileServingStats(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		Version: "Version",
+	}
+
+	conf := config.NewTSSql()
+	conf.Common.ReportEnable = false
+	conf.HTTP.StatsEnabled = true
+	conf.Sherlock.DumpPath = path.Join(t.TempDir(), "sherlock")
+	server, err := NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	s := server.(*Server)
+
+	level := logger.GetLevel()
+	defer logger.SetLevel(level)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			newConf := *conf
+			newConf.Monitor.Pushers = "http"
+			newConf.Monitor.StoreEnabled = i%2 == 0
+			newConf.Monitor.PushInterval = toml.Duration(time.Duration(i+1) * time.Second)
+			newConf.Logging.Level = zapcore.Level(i % 2)
+			require.NoError(t, s.Reload(&newConf))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			rec := httptest.NewRecorder()
+			s.httpService.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, conf.HTTP.StatsPath, nil))
+			require.Equal(t, http.StatusOK, rec.Code)
+			logger.GetLevel()
+		}
+	}()
+	wg.Wait()
+
+	require.Equal(t, 10*time.Second, time.Duration(s.conf().Monitor.PushInterval))
+	require.Nil(t, s.StatisticsPusher())
+}
+
+func TestServer_StatsHandler(t *testing.T) {
+	log := logger.NewLogger(errno.ModuleUnknown)
+	cmd := &cobra.Command{
+		Version: "Version",
+	}
+
+	conf := config.NewTSSql()
+	conf.Common.ReportEnable = false
+	conf.Sherlock.DumpPath = path.Join(t.TempDir(), "sherlock")
+
+	// disabled by default
+	server, err := NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	server.(*Server).httpService.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, conf.HTTP.StatsPath, nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	conf.HTTP.StatsEnabled = true
+	conf.HTTP.StatsPath = "/debug/sql-stats"
+	require.NoError(t, conf.Validate())
+	server, err = NewServer(conf, cmd, log)
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	server.(*Server).httpService.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/sql-stats", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	stats := make(map[string]map[string]interface{})
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	require.Equal(t, false, stats["castor"]["enabled"])
+	require.Equal(t, false, stats["sherlock"]["enabled"])
+	require.Equal(t, float64(0), stats["queries"]["running"])
+	require.Contains(t, stats, "connections")
+}
+
+func TestServer_Shutdown(t *testing.T) {
+	var err error
+	server := Server{}
+	server.Logger = logger.NewLogger(errno.ModuleUnknown)
+	server.QueryExecutor = query.NewExecutor()
+	server.Listener, err = net.Listen("tcp", "127.0.0.3:8899")
+	require.NoError(t, err)
+
+	q, err := influxql.ParseQuery("SELECT * FROM cpu")
+	require.NoError(t, err)
+	_, detach, err := server.QueryExecutor.TaskManager.AttachQuery(q, query.ExecutionOptions{}, nil, nil)
+	require.NoError(t, err)
+
+	var finished int64
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		atomic.StoreInt64(&finished, 1)
+		detach()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, server.Shutdown(ctx))
+	require.Equal(t, int64(1), atomic.LoadInt64(&finished))
+	require.Nil(t, server.Listener)
+}
+
+func TestServer_ShutdownTimeout(t *testing.T) {
+	server := Server{}
+	server.Logger = logger.NewLogger(errno.ModuleUnknown)
+	server.QueryExecutor = query.NewExecutor()
+
+	q, err := influxql.ParseQuery("SELECT * FROM cpu")
+	require.NoError(t, err)
+	_, detach, err := server.QueryExecutor.TaskManager.AttachQuery(q, query.ExecutionOptions{}, nil, nil)
+	require.NoError(t, err)
+	defer detach()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = server.Shutdown(ctx)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Contains(t, err.Error(), "1 queries aborted on shutdown: [1: SELECT * FROM cpu]")
+}
+
+func TestServer_CloseDrainQueries(t *testing.T) {
+	server := Server{}
+	server.Logger = logger.NewLogger(errno.ModuleUnknown)
+	server.config = config.NewTSSql()
+	server.config.Coordinator.ShutdownTimeout = toml.Duration(20 * time.Millisecond)
+	server.QueryExecutor = query.NewExecutor()
+
+	q, err := influxql.ParseQuery("SELECT * FROM cpu")
+	require.NoError(t, err)
+	tm := server.QueryExecutor.TaskManager
+	_, detachFinished, err := tm.AttachQuery(q, query.ExecutionOptions{}, nil, nil)
+	require.NoError(t, err)
+	qCtx, detach, err := tm.AttachQuery(q, query.ExecutionOptions{}, nil, nil)
+	require.NoError(t, err)
+	defer detach()
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		detachFinished()
+	}()
+
+	err = server.Close()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), fmt.Sprintf("1 queries aborted on shutdown: [%d: SELECT * FROM cpu]", qCtx.QueryID))
+
+	// the remaining query is aborted through the monitor machinery
+	select {
+	case <-qCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("query is not aborted")
+	}
+}
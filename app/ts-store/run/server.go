@@ -30,6 +30,7 @@ import (
 	"github.com/openGemini/openGemini/app/ts-store/stream"
 	"github.com/openGemini/openGemini/app/ts-store/transport"
 	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/httpserver"
@@ -271,7 +272,7 @@ func (s *Server) initStatisticsPusher() {
 	stat.InitImmutableStatistics(globalTags)
 	stat.InitMutableStatistics(globalTags)
 	stat.InitStoreQueryStatistics(globalTags)
-	stat.InitRuntimeStatistics(globalTags, int(time.Duration(s.config.Monitor.StoreInterval).Seconds()))
+	stat.InitRuntimeStatistics(globalTags, int(s.config.Monitor.GetPushInterval().Seconds()))
 	stat.InitIOStatistics(globalTags)
 	stat.NewMergeStatistics().Init(globalTags)
 	stat.NewCompactStatistics().Init(globalTags)
@@ -283,6 +284,7 @@ func (s *Server) initStatisticsPusher() {
 	stat.NewStreamStatistics().Init(globalTags)
 	stat.NewStreamWindowStatistics().Init(globalTags)
 	stat.NewRecordStatistics().Init(globalTags)
+	immutable.GetCompactStats().Init(globalTags)
 
 	s.statisticsPusher.Register(
 		stat.CollectPerfStatistics,
@@ -302,6 +304,7 @@ func (s *Server) initStatisticsPusher() {
 		stat.NewStreamStatistics().Collect,
 		stat.NewStreamWindowStatistics().Collect,
 		stat.NewRecordStatistics().Collect,
+		immutable.GetCompactStats().Collect,
 	)
 
 	s.statisticsPusher.RegisterOps(stat.CollectOpsPerfStatistics)
@@ -168,12 +168,14 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 	opt.CacheMetaBlock = conf.Data.CacheMetaBlock
 	opt.EnableMmapRead = conf.Data.EnableMmapRead
 	opt.ReadCacheLimit = uint64(conf.Data.ReadCacheLimit)
+	opt.BlockCacheLimit = uint64(conf.Data.BlockCacheLimit)
 	opt.WalSyncInterval = time.Duration(conf.Data.WalSyncInterval)
 	opt.WalEnabled = conf.Data.WalEnabled
 	opt.WalReplayParallel = conf.Data.WalReplayParallel
 	opt.WalReplayAsync = conf.Data.WalReplayAsync
 	opt.CompactionMethod = conf.Data.CompactionMethod
 	opt.OpenShardLimit = conf.Data.OpenShardLimit
+	opt.MaxConcurrentFileOperations = conf.Data.MaxConcurrentFileOperations
 	opt.DownSampleWriteDrop = conf.Data.DownSampleWriteDrop
 	opt.MaxDownSampleTaskConcurrency = conf.Data.MaxDownSampleTaskConcurrency
 
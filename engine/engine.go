@@ -136,6 +136,8 @@ func NewEngine(dataPath, walPath string, options netstorage.EngineOptions, ctx *
 	immutable.SetCacheMetaData(options.CacheMetaBlock)
 	immutable.EnableMmapRead(options.EnableMmapRead)
 	immutable.EnableReadCache(options.ReadCacheLimit)
+	immutable.SetBlockCacheSize(int64(options.BlockCacheLimit))
+	immutable.SetMaxConcurrentFileOperations(options.MaxConcurrentFileOperations)
 	immutable.SetCompactLimit(options.CompactThroughput, options.CompactThroughputBurst)
 	immutable.SetSnapshotLimit(options.SnapshotThroughput, options.SnapshotThroughputBurst)
 	immutable.SegMergeFlag(int32(options.CompactionMethod))
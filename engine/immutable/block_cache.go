@@ -0,0 +1,213 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/bufferpool"
+)
+
+// blockCache is shared by all tssp file readers, it is disabled until SetBlockCacheSize is called
+// with the data block-cache-limit of the store config
+var blockCache = NewBlockCache(0)
+
+// SetBlockCacheSize sets the size limit in bytes of the block cache shared by all tssp files,
+// the cache is disabled and purged if bytes <= 0
+func SetBlockCacheSize(bytes int64) {
+	blockCache.Resize(bytes)
+}
+
+func GetBlockCache() *BlockCache {
+	return blockCache
+}
+
+type blockCacheKey struct {
+	path   string
+	offset int64
+	size   uint32
+}
+
+type blockCacheEntry struct {
+	key  blockCacheKey
+	data []byte
+}
+
+// BlockCache caches data blocks read from tssp files,
+// blocks are evicted in LRU order once the total size exceeds the limit
+type BlockCache struct {
+	mu    sync.Mutex
+	limit int64
+	size  int64
+	lru   *list.List
+	items map[blockCacheKey]*list.Element
+	files map[string]map[blockCacheKey]struct{}
+
+	hits   int64
+	misses int64
+}
+
+func NewBlockCache(limit int64) *BlockCache {
+	c := &BlockCache{lru: list.New()}
+	c.purge()
+	c.Resize(limit)
+	return c
+}
+
+func (c *BlockCache) Enabled() bool {
+	return atomic.LoadInt64(&c.limit) > 0
+}
+
+// Resize changes the size limit of the cache, blocks are evicted if the cache exceeds the new limit
+func (c *BlockCache) Resize(limit int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if limit <= 0 {
+		limit = 0
+		c.purge()
+	}
+	atomic.StoreInt64(&c.limit, limit)
+	c.evict()
+}
+
+// Get copies the block of the file at offset into dst, which is grown if needed,
+// a new buffer is allocated if dst is nil. The cached bytes are never handed out
+func (c *BlockCache) Get(path string, offset int64, size uint32, dst *[]byte) ([]byte, bool) {
+	if !c.Enabled() {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	ele, ok := c.items[blockCacheKey{path: path, offset: offset, size: size}]
+	if ok {
+		c.lru.MoveToFront(ele)
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		atomic.AddInt64(&c.misses, 1)
+		return nil, false
+	}
+	atomic.AddInt64(&c.hits, 1)
+
+	// entries are never modified once added, so the copy needs no lock
+	data := ele.Value.(*blockCacheEntry).data
+	var buf []byte
+	if dst != nil {
+		*dst = bufferpool.Resize(*dst, len(data))
+		buf = *dst
+	} else {
+		buf = make([]byte, len(data))
+	}
+	copy(buf, data)
+	return buf, true
+}
+
+// Add copies data into the cache, blocks larger than the limit are not cached
+func (c *BlockCache) Add(path string, offset int64, data []byte) {
+	if !c.Enabled() {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if int64(len(data)) > c.limit {
+		return
+	}
+	key := blockCacheKey{path: path, offset: offset, size: uint32(len(data))}
+	if ele, ok := c.items[key]; ok {
+		c.lru.MoveToFront(ele)
+		return
+	}
+
+	entry := &blockCacheEntry{key: key, data: append([]byte(nil), data...)}
+	c.items[key] = c.lru.PushFront(entry)
+	keys, ok := c.files[path]
+	if !ok {
+		keys = make(map[blockCacheKey]struct{})
+		c.files[path] = keys
+	}
+	keys[key] = struct{}{}
+	c.size += int64(len(data))
+	c.evict()
+}
+
+// RemoveFile removes all blocks of the file, it is called when the file is closed or renamed
+func (c *BlockCache) RemoveFile(path string) {
+	if !c.Enabled() {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.files[path] {
+		c.remove(c.items[key])
+	}
+}
+
+// Size returns the total size of the cached blocks in bytes
+func (c *BlockCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+func (c *BlockCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// HitRatio returns the ratio of the Get calls hitting the cache
+func (c *BlockCache) HitRatio() float64 {
+	hits, misses := atomic.LoadInt64(&c.hits), atomic.LoadInt64(&c.misses)
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+func (c *BlockCache) evict() {
+	for c.size > c.limit {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *BlockCache) remove(ele *list.Element) {
+	entry := c.lru.Remove(ele).(*blockCacheEntry)
+	delete(c.items, entry.key)
+	keys := c.files[entry.key.path]
+	delete(keys, entry.key)
+	if len(keys) == 0 {
+		delete(c.files, entry.key.path)
+	}
+	c.size -= int64(len(entry.data))
+}
+
+func (c *BlockCache) purge() {
+	c.lru.Init()
+	c.items = make(map[blockCacheKey]*list.Element)
+	c.files = make(map[string]map[blockCacheKey]struct{})
+	c.size = 0
+	atomic.StoreInt64(&c.hits, 0)
+	atomic.StoreInt64(&c.misses, 0)
+}
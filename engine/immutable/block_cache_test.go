@@ -0,0 +1,213 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockCache(t *testing.T) {
+	c := NewBlockCache(0)
+	c.Add("a", 0, make([]byte, 10))
+	_, ok := c.Get("a", 0, 10, nil)
+	require.False(t, ok)
+	require.Equal(t, 0, c.Len())
+
+	c.Resize(30)
+	c.Add("a", 0, []byte("0123456789"))
+	c.Add("a", 10, make([]byte, 10))
+	c.Add("b", 0, make([]byte, 10))
+	require.Equal(t, int64(30), c.Size())
+
+	b, ok := c.Get("a", 0, 10, nil)
+	require.True(t, ok)
+	require.Equal(t, []byte("0123456789"), b)
+
+	// the block is copied into dst, changing it does not change the cache
+	dst := make([]byte, 0, 4)
+	b, ok = c.Get("a", 0, 10, &dst)
+	require.True(t, ok)
+	require.Equal(t, []byte("0123456789"), dst)
+	b[0] = 'x'
+	b, _ = c.Get("a", 0, 10, nil)
+	require.Equal(t, []byte("0123456789"), b)
+	_, ok = c.Get("a", 0, 5, nil)
+	require.False(t, ok)
+
+	// a/10 is the least recently used one
+	c.Add("b", 10, make([]byte, 10))
+	require.Equal(t, 3, c.Len())
+	_, ok = c.Get("a", 10, 10, nil)
+	require.False(t, ok)
+	_, ok = c.Get("a", 0, 10, nil)
+	require.True(t, ok)
+
+	// blocks larger than the limit are not cached
+	c.Add("c", 0, make([]byte, 31))
+	require.Equal(t, int64(30), c.Size())
+
+	c.RemoveFile("b")
+	require.Equal(t, 1, c.Len())
+	require.Equal(t, int64(10), c.Size())
+	c.RemoveFile("not_exist")
+	require.Equal(t, 1, c.Len())
+
+	c.Resize(5)
+	require.Equal(t, 0, c.Len())
+	require.Equal(t, int64(0), c.Size())
+
+	c.Resize(0)
+	require.False(t, c.Enabled())
+	require.Equal(t, float64(0), c.HitRatio())
+}
+
+func writeBlockCacheTestFiles(tb testing.TB, dir string, n int) []TSSPFile {
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	for i := 0; i < n; i++ {
+		var idMinMax, tmMinMax MinMax
+		ids, data := genMemTableData(1, 10, 100, &idMinMax, &tmMinMax)
+		fileName := NewTSSPFileName(uint64(i+1), 0, 0, 0, true, &lockPath)
+		msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+		for _, id := range ids {
+			require.NoError(tb, msb.WriteData(id, data[id]))
+		}
+		store.AddTable(msb, true, false)
+	}
+
+	fs := store.tableFiles("mst", true)
+	require.Equal(tb, n, fs.Len())
+	return fs.Files()
+}
+
+func readBlockCacheTestFiles(tb testing.TB, files []TSSPFile, buf *[]byte) {
+	for _, f := range files {
+		mi, err := f.MetaIndexAt(0)
+		require.NoError(tb, err)
+		metas, err := f.ReadChunkMetaData(0, mi, nil)
+		require.NoError(tb, err)
+		for i := range metas {
+			_, err = f.ReadDataBlock(metas[i].offset, metas[i].size, buf)
+			require.NoError(tb, err)
+		}
+	}
+}
+
+func TestBlockCache_TSSPFile(t *testing.T) {
+	defer SetBlockCacheSize(0)
+	files := writeBlockCacheTestFiles(t, t.TempDir(), 2)
+	defer func() {
+		for _, f := range files {
+			require.NoError(t, f.Close())
+		}
+	}()
+
+	SetBlockCacheSize(64 * 1024 * 1024)
+	var buf []byte
+	readBlockCacheTestFiles(t, files, &buf)
+	cached := GetBlockCache().Len()
+	require.True(t, cached > 0)
+
+	// the same blocks are read from the cache
+	readBlockCacheTestFiles(t, files, &buf)
+	require.Equal(t, cached, GetBlockCache().Len())
+	require.True(t, GetBlockCache().HitRatio() >= 0.5)
+
+	mi, err := files[0].MetaIndexAt(0)
+	require.NoError(t, err)
+	metas, err := files[0].ReadChunkMetaData(0, mi, nil)
+	require.NoError(t, err)
+	cm := &metas[0]
+	expect, err := files[0].(*tsspFile).reader.(*tsspFileReader).r.ReadAt(cm.offset, cm.size, &buf)
+	require.NoError(t, err)
+	got, err := files[0].ReadDataBlock(cm.offset, cm.size, nil)
+	require.NoError(t, err)
+	require.Equal(t, expect, got)
+
+	require.NoError(t, files[0].Close())
+	files = files[1:]
+	require.True(t, GetBlockCache().Len() < cached)
+}
+
+func TestBlockCache_BufferReader(t *testing.T) {
+	defer SetBlockCacheSize(0)
+	files := writeBlockCacheTestFiles(t, t.TempDir(), 1)
+	defer func() {
+		require.NoError(t, files[0].Close())
+	}()
+
+	f := files[0]
+	mi, err := f.MetaIndexAt(0)
+	require.NoError(t, err)
+	metas, err := f.ReadChunkMetaData(0, mi, nil)
+	require.NoError(t, err)
+
+	const window = 64 * 1024
+	for i := range metas {
+		require.True(t, metas[i].size < window)
+	}
+	readAll := func() [][]byte {
+		reader := NewBufferReader(window)
+		reader.Reset(f)
+		var blocks [][]byte
+		for i := range metas {
+			b, err := reader.Read(metas[i].offset, metas[i].size)
+			require.NoError(t, err)
+			blocks = append(blocks, append([]byte(nil), b...))
+		}
+		return blocks
+	}
+
+	expect := readAll()
+	SetBlockCacheSize(64 * 1024 * 1024)
+	require.Equal(t, expect, readAll())
+	require.True(t, GetBlockCache().Len() > 0)
+
+	// blocks preRead by the buffer reader are now served by the cache
+	require.Equal(t, expect, readAll())
+	require.True(t, GetBlockCache().HitRatio() >= 0.5)
+}
+
+func BenchmarkBlockCache(b *testing.B) {
+	files := writeBlockCacheTestFiles(b, b.TempDir(), 8)
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+
+	for _, size := range []int64{0, 64 * 1024 * 1024} {
+		b.Run(fmt.Sprintf("cache-size-%d", size), func(b *testing.B) {
+			SetBlockCacheSize(size)
+			defer SetBlockCacheSize(0)
+
+			var buf []byte
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				readBlockCacheTestFiles(b, files, &buf)
+			}
+			b.ReportMetric(GetBlockCache().HitRatio(), "hit-ratio")
+		})
+	}
+}
@@ -159,7 +159,7 @@ func (c *ChunkIterators) Next() (uint64, *record.Record, error) {
 func NewChunkIterator(r *FileIterator) *ChunkIterator {
 	itr := &ChunkIterator{
 		FileIterator: r,
-		ctx:          NewReadContext(true),
+		ctx:          AcquireReadContext(),
 		merge:        allocRecord(),
 		rec:          allocRecord(),
 	}
@@ -175,7 +175,7 @@ func (c *ChunkIterator) Close() {
 	c.FileIterator.Close()
 	freeRecord(c.rec)
 	freeRecord(c.merge)
-	c.ctx.Release()
+	ReleaseReadContext(c.ctx)
 	c.ctx = nil
 }
 
@@ -18,6 +18,7 @@ package immutable
 
 import (
 	"container/heap"
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -138,21 +139,52 @@ func (m *MmsTables) unrefMmsTable(orderWg, outOfOrderWg *sync.WaitGroup) {
 	}
 }
 
+// compactContext returns a context that is canceled when the tables are closed or compaction is stopped
+func (m *MmsTables) compactContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	closed, stop := m.closed, m.stopCompMerge
+	go func() {
+		select {
+		case <-closed:
+		case <-stop:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+	return ctx, cancel
+}
+
 func (m *MmsTables) LevelCompact(level uint16, shid uint64) error {
+	ctx, cancel := m.compactContext()
+	defer cancel()
+
 	plans := m.LevelPlan(level)
 	for len(plans) > 0 {
 		plan := plans[0]
 		plan.shardId = shid
+		if err := compactionLimiter.Acquire(ctx, level); err != nil {
+			select {
+			case <-m.closed:
+				return ErrCompStopped
+			default:
+				log.Info("stop LevelCompact", zap.Uint64("id", shid))
+				return nil
+			}
+		}
+
 		select {
 		case <-m.closed:
+			compactionLimiter.Release(level)
 			return ErrCompStopped
 		case <-m.stopCompMerge:
+			compactionLimiter.Release(level)
 			log.Info("stop LevelCompact", zap.Uint64("id", shid))
 			return nil
 		case compLimiter <- struct{}{}:
 			m.wg.Add(1)
 			if !m.CompactionEnabled() {
 				m.wg.Done()
+				compactionLimiter.Release(level)
 				return nil
 			}
 			go func(group *CompactGroup) {
@@ -165,6 +197,7 @@ func (m *MmsTables) LevelCompact(level uint16, shid uint64) error {
 					m.wg.Done()
 					m.unrefMmsTable(orderWg, inorderWg)
 					compLimiter.Release()
+					compactionLimiter.Release(level)
 					m.CompactDone(group.group)
 					group.release()
 				}()
@@ -276,11 +309,13 @@ func (m *MmsTables) compactToLevel(group FilesInfo, full, isNonStream bool) erro
 	end := time.Now()
 	lcLog.Debug("compact file done", zap.Any("files", group.oldFids), zap.Time("end", end), zap.Duration("time used", end.Sub(start)))
 
+	newFilesSize := SumFilesSize(newFiles)
+	compactStats.RecordCompaction(group.toLevel, int64(oldFilesSize), newFilesSize, end.Sub(start))
 	if oldFilesSize != 0 {
 		compactStatItem.OriginalFileCount = int64(len(group.oldFiles))
 		compactStatItem.CompactedFileCount = int64(len(newFiles))
 		compactStatItem.OriginalFileSize = int64(oldFilesSize)
-		compactStatItem.CompactedFileSize = SumFilesSize(newFiles)
+		compactStatItem.CompactedFileSize = newFilesSize
 	}
 	return nil
 }
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+)
+
+// CompactLevelStats is the aggregate of the compactions to a level
+type CompactLevelStats struct {
+	Count    int64
+	InBytes  int64
+	OutBytes int64
+	Duration time.Duration
+}
+
+// CompactStats aggregates the compactions per target level since the process started
+type CompactStats struct {
+	mu     sync.RWMutex
+	levels map[uint16]CompactLevelStats
+	tags   map[string]string
+}
+
+var compactStats = NewCompactStats()
+
+func NewCompactStats() *CompactStats {
+	return &CompactStats{
+		levels: make(map[uint16]CompactLevelStats),
+	}
+}
+
+// GetCompactStats returns the compaction statistics of the tssp files of this process
+func GetCompactStats() *CompactStats {
+	return compactStats
+}
+
+func (s *CompactStats) Init(tags map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tags = make(map[string]string)
+	statistics.AllocTagMap(s.tags, tags)
+}
+
+func (s *CompactStats) RecordCompaction(toLevel uint16, inBytes, outBytes int64, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.levels[toLevel]
+	stats.Count++
+	stats.InBytes += inBytes
+	stats.OutBytes += outBytes
+	stats.Duration += d
+	s.levels[toLevel] = stats
+}
+
+// Snapshot returns a copy of the statistics of each target level
+func (s *CompactStats) Snapshot() map[uint16]CompactLevelStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	snapshot := make(map[uint16]CompactLevelStats, len(s.levels))
+	for level, stats := range s.levels {
+		snapshot[level] = stats
+	}
+	return snapshot
+}
+
+// Collect appends the statistics of each target level to buffer, it is registered to the statistics pusher
+func (s *CompactStats) Collect(buffer []byte) ([]byte, error) {
+	snapshot := s.Snapshot()
+	levels := make([]int, 0, len(snapshot))
+	for level := range snapshot {
+		levels = append(levels, int(level))
+	}
+	sort.Ints(levels)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, level := range levels {
+		stats := snapshot[uint16(level)]
+		tags := map[string]string{"level": strconv.Itoa(level)}
+		statistics.AllocTagMap(tags, s.tags)
+		data := map[string]interface{}{
+			"Count":    stats.Count,
+			"InBytes":  stats.InBytes,
+			"OutBytes": stats.OutBytes,
+			"Duration": stats.Duration.Milliseconds(),
+		}
+		buffer = statistics.AddPointToBuffer("compact_level", tags, data, buffer)
+	}
+	return buffer, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactStats(t *testing.T) {
+	stats := NewCompactStats()
+	stats.Init(map[string]string{"hostname": "127.0.0.1"})
+
+	stats.RecordCompaction(1, 100, 80, time.Second)
+	stats.RecordCompaction(1, 200, 150, 2*time.Second)
+	stats.RecordCompaction(2, 1000, 900, 3*time.Second)
+
+	snapshot := stats.Snapshot()
+	require.Equal(t, map[uint16]CompactLevelStats{
+		1: {Count: 2, InBytes: 300, OutBytes: 230, Duration: 3 * time.Second},
+		2: {Count: 1, InBytes: 1000, OutBytes: 900, Duration: 3 * time.Second},
+	}, snapshot)
+
+	// the snapshot is a copy
+	stats.RecordCompaction(2, 1, 1, time.Millisecond)
+	require.Equal(t, int64(1), snapshot[2].Count)
+
+	buf, err := stats.Collect(nil)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	require.Equal(t, 2, len(lines))
+	require.True(t, strings.HasPrefix(lines[0], "compact_level,"))
+	require.Contains(t, lines[0], "level=1")
+	require.Contains(t, lines[0], "hostname=127.0.0.1")
+	for _, field := range []string{"Count=2", "InBytes=300", "OutBytes=230", "Duration=3000"} {
+		require.Contains(t, lines[0], field)
+	}
+	require.Contains(t, lines[1], "level=2")
+	for _, field := range []string{"Count=2", "InBytes=1001", "OutBytes=901", "Duration=3001"} {
+		require.Contains(t, lines[1], field)
+	}
+}
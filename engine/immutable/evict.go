@@ -25,12 +25,14 @@ import (
 
 	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/openGemini/openGemini/lib/cpu"
+	stats "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"go.uber.org/zap"
 )
 
 type memReaderEvictCtx struct {
 	evictList     []memReaderEvictList
 	evictListName []string
+	memBudgets    []int64 // memory budget of each level in bytes, 0 means unlimited
 }
 
 type memReaderEvictList struct {
@@ -55,6 +57,7 @@ func NewMemReaderEvictCtx() *memReaderEvictCtx {
 	for i := range ctx.evictList {
 		ctx.evictListName[i] = strconv.Itoa(i)
 	}
+	ctx.memBudgets = make([]int64, CompactLevels+1)
 
 	return ctx
 }
@@ -80,6 +83,64 @@ func (ctx *memReaderEvictCtx) evictMemReader(evictSize int64) {
 	}
 }
 
+// enforceLevelMemBudgets evicts the least recently used files of each level whose memory exceeds its budget,
+// files in use are kept
+func (ctx *memReaderEvictCtx) enforceLevelMemBudgets() {
+	for i := range ctx.memBudgets {
+		level := uint16(i)
+		budget := atomic.LoadInt64(&ctx.memBudgets[i])
+		if budget <= 0 {
+			continue
+		}
+		used := levelMemSize(level)
+		if used <= budget {
+			continue
+		}
+
+		freed := ctx.evictLevel(level, used-budget)
+		log.Info("evict files over the level memory budget", zap.Uint16("level", level),
+			zap.Int64("used", used), zap.Int64("budget", budget), zap.Int64("freed", freed))
+	}
+}
+
+func (ctx *memReaderEvictCtx) evictLevel(level uint16, evictSize int64) int64 {
+	var freed int64
+	l := levelEvictListLock(level)
+	defer levelEvictListUnLock(level)
+
+	e := l.Back()
+	for e != nil && freed < evictSize {
+		f := e.Value.(TSSPFile)
+		e = e.Prev()
+		if f.Inuse() {
+			continue
+		}
+		if size := f.Free(false); size > 0 {
+			freed += size
+		}
+	}
+	return freed
+}
+
+// SetLevelMemBudget sets the memory budget in bytes of the files of level loaded into memory,
+// files over the budget are evicted in the background. budget <= 0 removes the budget
+func SetLevelMemBudget(level uint16, budget int64) {
+	atomic.StoreInt64(&nodeEvictCtx.memBudgets[getEvictListIdx(level)], budget)
+}
+
+func levelMemBudget(level uint16) int64 {
+	return atomic.LoadInt64(&nodeEvictCtx.memBudgets[getEvictListIdx(level)])
+}
+
+func levelMemSize(level uint16) int64 {
+	stats.ImmutableStat.Mu.RLock()
+	defer stats.ImmutableStat.Mu.RUnlock()
+	if item, ok := stats.ImmutableStat.Stats[levelName(level)]; ok {
+		return atomic.LoadInt64(&item.ImmuMemSize)
+	}
+	return 0
+}
+
 func getEvictListIdx(level uint16) uint16 {
 	listLen := uint16(len(nodeEvictCtx.evictList))
 	if level >= listLen {
@@ -109,6 +170,39 @@ func levelName(level uint16) string {
 	return nodeEvictCtx.evictListName[idx]
 }
 
+type LevelMemStat struct {
+	MemSize        int64
+	OrderMemSize   int64
+	UnOrderMemSize int64
+	EvictListLen   int
+	MemBudget      int64 // 0 means the level has no memory budget
+}
+
+// LevelMemStats returns a snapshot of the in-memory size and the evict list length of each level
+func LevelMemStats() map[uint16]LevelMemStat {
+	levelStats := make(map[uint16]LevelMemStat, len(nodeEvictCtx.evictList))
+	for i := range nodeEvictCtx.evictList {
+		level := uint16(i)
+		stat := LevelMemStat{}
+
+		l := levelEvictListLock(level)
+		stat.EvictListLen = l.Len()
+		levelEvictListUnLock(level)
+		stat.MemBudget = levelMemBudget(level)
+
+		stats.ImmutableStat.Mu.RLock()
+		if item, ok := stats.ImmutableStat.Stats[levelName(level)]; ok {
+			stat.MemSize = atomic.LoadInt64(&item.ImmuMemSize)
+			stat.OrderMemSize = atomic.LoadInt64(&item.ImmuMemOrderSize)
+			stat.UnOrderMemSize = atomic.LoadInt64(&item.ImmuMemUnOrderSize)
+		}
+		stats.ImmutableStat.Mu.RUnlock()
+
+		levelStats[level] = stat
+	}
+	return levelStats
+}
+
 func getImmTableEvictSize() int64 {
 	nodeSize := atomic.LoadInt64(&nodeImmTableSizeUsed)
 	if nodeSize > nodeImmTableSizeLimit {
@@ -0,0 +1,224 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+
+	"github.com/openGemini/openGemini/lib/codec"
+	"github.com/openGemini/openGemini/lib/errno"
+	Log "github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/record"
+	"go.uber.org/zap"
+)
+
+// The export stream starts with exportMagic and exportVersion, followed by frames.
+// A frame is made up of a 1-byte frame type, a 4-byte payload size and the payload.
+const (
+	exportMagic   = "OGEXPORT"
+	exportVersion = uint32(1)
+
+	exportFrameSchema = uint8(1) // the marshaled measurement info
+	exportFrameFile   = uint8(2) // the file name, the following series belong to the file
+	exportFrameSeries = uint8(3) // the series id and the record of the series
+	exportFrameEnd    = uint8(4) // all files have been exported
+)
+
+// ExportProgress records which part of the files has been exported, an Exporter
+// created with the progress of an interrupted export continues from where it stopped
+type ExportProgress struct {
+	Done     []string // files exported completely
+	File     string   // file being exported
+	SeriesID uint64   // the last series of File which has been exported
+}
+
+func (p *ExportProgress) done(file string) bool {
+	for _, name := range p.Done {
+		if name == file {
+			return true
+		}
+	}
+	return false
+}
+
+// Exporter writes the series of all files of a measurement into a self-describing stream
+type Exporter struct {
+	files    *TSSPFiles
+	mst      encoding.BinaryMarshaler // usually a *meta.MeasurementInfo
+	progress ExportProgress
+	log      *Log.Logger
+
+	buf []byte
+}
+
+func NewExporter(files *TSSPFiles, mst encoding.BinaryMarshaler, progress ExportProgress) *Exporter {
+	progress.Done = append([]string(nil), progress.Done...)
+	return &Exporter{
+		files:    files,
+		mst:      mst,
+		progress: progress,
+		log:      Log.NewLogger(errno.ModuleTssp),
+	}
+}
+
+// Progress returns the progress of the export, it is updated after each series is written
+func (e *Exporter) Progress() ExportProgress {
+	p := e.progress
+	p.Done = append([]string(nil), p.Done...)
+	return p
+}
+
+// Export writes the files which have not been exported to w,
+// the files are referenced during the export so they are not removed by compaction
+func (e *Exporter) Export(w io.Writer) error {
+	schema, err := e.mst.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	e.files.lock.RLock()
+	files := make([]TSSPFile, len(e.files.files))
+	copy(files, e.files.files)
+	for _, f := range files {
+		f.Ref()
+		f.RefFileReader()
+	}
+	e.files.lock.RUnlock()
+
+	exported := 0
+	defer func() {
+		for _, f := range files[exported:] {
+			f.UnrefFileReader()
+			f.Unref()
+		}
+	}()
+
+	e.buf = append(e.buf[:0], exportMagic...)
+	e.buf = numberenc.MarshalUint32Append(e.buf, exportVersion)
+	if _, err = w.Write(e.buf); err != nil {
+		return err
+	}
+	if err = e.writeFrame(w, exportFrameSchema, schema); err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if e.progress.done(f.Path()) {
+			exported++
+			f.UnrefFileReader()
+			f.Unref()
+			continue
+		}
+
+		exported++
+		if err = e.exportFile(w, f); err != nil {
+			e.log.Error("export file failed", zap.String("file", f.Path()), zap.Error(err))
+			return err
+		}
+	}
+
+	return e.writeFrame(w, exportFrameEnd, nil)
+}
+
+// exportFile writes the series of f, f is unreferenced when it returns
+func (e *Exporter) exportFile(w io.Writer, f TSSPFile) error {
+	name := f.Path()
+	itr := NewChunkIterator(NewFileIterator(f, e.log))
+	itr.WithLog(e.log)
+	defer itr.Close()
+
+	if err := e.writeFrame(w, exportFrameFile, []byte(name)); err != nil {
+		return err
+	}
+
+	resume := e.progress.File == name
+	var payload []byte
+	for itr.Next() {
+		id := itr.GetSeriesID()
+		if resume && id <= e.progress.SeriesID {
+			continue
+		}
+
+		payload = numberenc.MarshalUint64Append(payload[:0], id)
+		payload = marshalExportRecord(payload, itr.GetRecord())
+		if err := e.writeFrame(w, exportFrameSeries, payload); err != nil {
+			return err
+		}
+		e.progress.File = name
+		e.progress.SeriesID = id
+	}
+	if itr.err != nil {
+		return itr.err
+	}
+
+	e.progress.Done = append(e.progress.Done, name)
+	e.progress.File = ""
+	e.progress.SeriesID = 0
+	return nil
+}
+
+func (e *Exporter) writeFrame(w io.Writer, typ uint8, payload []byte) error {
+	e.buf = append(e.buf[:0], typ)
+	e.buf = numberenc.MarshalUint32Append(e.buf, uint32(len(payload)))
+	e.buf = append(e.buf, payload...)
+	_, err := w.Write(e.buf)
+	return err
+}
+
+func marshalExportRecord(dst []byte, rec *record.Record) []byte {
+	dst = codec.AppendInt(dst, rec.ColNums())
+	for i := range rec.Schema {
+		cv := &rec.ColVals[i]
+		dst = codec.AppendString(dst, rec.Schema[i].Name)
+		dst = codec.AppendInt(dst, rec.Schema[i].Type)
+		dst = codec.AppendInt(dst, cv.Len)
+		dst = codec.AppendInt(dst, cv.NilCount)
+		dst = codec.AppendInt(dst, cv.BitMapOffset)
+		dst = codec.AppendBytes(dst, cv.Val)
+		dst = codec.AppendUint32Slice(dst, cv.Offset)
+		dst = codec.AppendBytes(dst, cv.Bitmap)
+	}
+	return dst
+}
+
+func unmarshalExportRecord(src []byte) (*record.Record, error) {
+	dec := codec.NewBinaryDecoder(src)
+	n := dec.Int()
+	if n < 0 || n > len(src) {
+		return nil, fmt.Errorf("invalid column count %d", n)
+	}
+
+	rec := &record.Record{
+		Schema:  make(record.Schemas, n),
+		ColVals: make([]record.ColVal, n),
+	}
+	for i := 0; i < n; i++ {
+		cv := &rec.ColVals[i]
+		rec.Schema[i].Name = dec.String()
+		rec.Schema[i].Type = dec.Int()
+		cv.Len = dec.Int()
+		cv.NilCount = dec.Int()
+		cv.BitMapOffset = dec.Int()
+		cv.Val = dec.Bytes()
+		cv.Offset = dec.Uint32Slice()
+		cv.Bitmap = dec.Bytes()
+	}
+	return rec, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/stretchr/testify/require"
+)
+
+type exportTestSchema []byte
+
+func (s exportTestSchema) MarshalBinary() ([]byte, error) {
+	return s, nil
+}
+
+type exportFrame struct {
+	typ     uint8
+	payload []byte
+}
+
+func readExportFrames(t *testing.T, buf []byte) []exportFrame {
+	require.True(t, len(buf) >= len(exportMagic)+4)
+	require.Equal(t, exportMagic, string(buf[:len(exportMagic)]))
+	buf = buf[len(exportMagic):]
+	require.Equal(t, exportVersion, numberenc.UnmarshalUint32(buf))
+	buf = buf[4:]
+
+	var frames []exportFrame
+	for len(buf) > 0 {
+		require.True(t, len(buf) >= 5)
+		typ, size := buf[0], int(numberenc.UnmarshalUint32(buf[1:]))
+		buf = buf[5:]
+		require.True(t, len(buf) >= size)
+		frames = append(frames, exportFrame{typ: typ, payload: buf[:size]})
+		buf = buf[size:]
+	}
+	return frames
+}
+
+// failWriter fails after n writes
+type failWriter struct {
+	bytes.Buffer
+	n int
+}
+
+func (w *failWriter) Write(p []byte) (int, error) {
+	if w.n <= 0 {
+		return 0, errors.New("write failed")
+	}
+	w.n--
+	return w.Buffer.Write(p)
+}
+
+func TestExporter(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	expect := make(map[uint64]*record.Record)
+	for i := 0; i < 2; i++ {
+		var idMinMax, tmMinMax MinMax
+		ids, data := genMemTableData(uint64(i*10+1), 5, 50, &idMinMax, &tmMinMax)
+		fileName := NewTSSPFileName(uint64(i+1), 0, 0, 0, true, &lockPath)
+		msb := NewMsBuilder(dir, "mst", &lockPath, conf, 2, fileName, 0, store.Sequencer(), 2)
+		for _, id := range ids {
+			require.NoError(t, msb.WriteData(id, data[id]))
+			expect[id] = data[id]
+		}
+		store.AddTable(msb, true, false)
+	}
+	fs := store.tableFiles("mst", true)
+	require.Equal(t, 2, fs.Len())
+	defer fs.StopFiles()
+
+	checkSeries := func(payload []byte, got map[uint64]*record.Record) {
+		id := numberenc.UnmarshalUint64(payload)
+		rec, err := unmarshalExportRecord(payload[8:])
+		require.NoError(t, err)
+		_, ok := got[id]
+		require.False(t, ok, "series %d is exported twice", id)
+		got[id] = rec
+
+		exp, ok := expect[id]
+		require.True(t, ok)
+		require.Equal(t, exp.Schema, rec.Schema)
+		require.Equal(t, exp.Times(), rec.Times())
+		for i := range exp.ColVals {
+			require.Equal(t, exp.ColVals[i].Len, rec.ColVals[i].Len)
+			require.Equal(t, exp.ColVals[i].NilCount, rec.ColVals[i].NilCount)
+			require.Equal(t, exp.ColVals[i].Val, rec.ColVals[i].Val)
+		}
+	}
+	checkRef := func() {
+		for _, f := range fs.Files() {
+			require.Equal(t, int32(1), f.(*tsspFile).ref)
+		}
+	}
+
+	schema := exportTestSchema("mst_0000")
+	var buf bytes.Buffer
+	e := NewExporter(fs, schema, ExportProgress{})
+	require.NoError(t, e.Export(&buf))
+	checkRef()
+
+	frames := readExportFrames(t, buf.Bytes())
+	require.Equal(t, exportFrame{typ: exportFrameSchema, payload: schema}, frames[0])
+	require.Equal(t, exportFrame{typ: exportFrameEnd, payload: []byte{}}, frames[len(frames)-1])
+	got := make(map[uint64]*record.Record)
+	var files []string
+	for _, frame := range frames[1 : len(frames)-1] {
+		switch frame.typ {
+		case exportFrameFile:
+			files = append(files, string(frame.payload))
+		case exportFrameSeries:
+			checkSeries(frame.payload, got)
+		default:
+			t.Fatalf("unexpected frame type %d", frame.typ)
+		}
+	}
+	require.Equal(t, []string{fs.Files()[0].Path(), fs.Files()[1].Path()}, files)
+	require.Equal(t, len(expect), len(got))
+	require.Equal(t, ExportProgress{Done: files}, e.Progress())
+
+	// the export is interrupted in the middle of the second file and resumed
+	w := &failWriter{n: 11}
+	e = NewExporter(fs, schema, ExportProgress{})
+	require.EqualError(t, e.Export(w), "write failed")
+	checkRef()
+	progress := e.Progress()
+	require.Equal(t, files[:1], progress.Done)
+	require.Equal(t, files[1], progress.File)
+	require.Equal(t, uint64(12), progress.SeriesID)
+
+	var resumed bytes.Buffer
+	e = NewExporter(fs, schema, progress)
+	require.NoError(t, e.Export(&resumed))
+	checkRef()
+	require.Equal(t, ExportProgress{Done: files}, e.Progress())
+
+	got = make(map[uint64]*record.Record)
+	for _, b := range [][]byte{w.Bytes(), resumed.Bytes()} {
+		for _, frame := range readExportFrames(t, b) {
+			if frame.typ == exportFrameSeries {
+				checkSeries(frame.payload, got)
+			}
+		}
+	}
+	require.Equal(t, len(expect), len(got))
+}
@@ -239,6 +239,25 @@ func (i FileIterators) Close() {
 	}
 }
 
+// FilterByIDRange drops the iterators of the files whose series ids do not overlap with [min, max],
+// the dropped iterators are closed. Files whose series id range can not be read are kept
+func (i FileIterators) FilterByIDRange(min, max uint64) FileIterators {
+	n := 0
+	for _, itr := range i {
+		fMin, fMax, err := itr.r.MinMaxSeriesID()
+		if err == nil && (fMax < min || fMin > max) {
+			itr.Close()
+			continue
+		}
+		i[n] = itr
+		n++
+	}
+	for j := n; j < len(i); j++ {
+		i[j] = nil
+	}
+	return i[:n]
+}
+
 func (i FileIterators) MaxChunkRows() int {
 	max := 0
 	for _, itr := range i {
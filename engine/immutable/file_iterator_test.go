@@ -25,6 +25,7 @@ import (
 )
 
 func TestBufferReader(t *testing.T) {
+	defer beforeTest(t, 0)()
 	mh := NewMergeTestHelper(immutable.NewConfig())
 	defer mh.store.Close()
 	rg := newRecordGenerator(1e12, defaultInterval, true)
@@ -19,6 +19,7 @@ package immutable
 import (
 	"context"
 	"io"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -29,6 +30,8 @@ var (
 	compWriteLimiter           = NewLimiter(48*1024*1024, 64*1024*1024)
 	snapshotWriteLimiter       = NewLimiter(48*1024*1024, 64*1024*1024)
 	snapshotNoLimit      int32 = 0
+
+	compactionLimiter = NewCompactionLimiter()
 )
 
 func SnapshotLimit() bool {
@@ -55,6 +58,88 @@ func SetSnapshotLimit(bytesPerSec int64, burstLimit int64) {
 	snapshotWriteLimiter.SetBurst(int(burstLimit))
 }
 
+// SetCompactionConcurrency limits the number of level compactions of level that run at the same time,
+// n <= 0 removes the limit
+func SetCompactionConcurrency(level uint16, n int) {
+	compactionLimiter.SetConcurrency(level, n)
+}
+
+// CompactionLimiter bounds the number of concurrent compactions of each level,
+// levels without a limit are only bounded by the global compactor limit
+type CompactionLimiter struct {
+	mu     sync.Mutex
+	levels map[uint16]*levelTokens
+}
+
+type levelTokens struct {
+	limit   int
+	running int
+	wait    chan struct{} // closed to wake up the waiters when a token may be available
+}
+
+func NewCompactionLimiter() *CompactionLimiter {
+	return &CompactionLimiter{levels: make(map[uint16]*levelTokens)}
+}
+
+func (l *CompactionLimiter) tokens(level uint16) *levelTokens {
+	t, ok := l.levels[level]
+	if !ok {
+		t = &levelTokens{}
+		l.levels[level] = t
+	}
+	return t
+}
+
+func (t *levelTokens) wakeUp() {
+	if t.wait != nil {
+		close(t.wait)
+		t.wait = nil
+	}
+}
+
+func (l *CompactionLimiter) SetConcurrency(level uint16, n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t := l.tokens(level)
+	t.limit = n
+	t.wakeUp()
+}
+
+// Acquire takes a token of level, it blocks until a token is released or ctx is done
+func (l *CompactionLimiter) Acquire(ctx context.Context, level uint16) error {
+	for {
+		l.mu.Lock()
+		t := l.tokens(level)
+		if t.limit <= 0 || t.running < t.limit {
+			t.running++
+			l.mu.Unlock()
+			return nil
+		}
+		if t.wait == nil {
+			t.wait = make(chan struct{})
+		}
+		wait := t.wait
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// Release returns a token taken by Acquire
+func (l *CompactionLimiter) Release(level uint16) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t := l.tokens(level)
+	if t.running > 0 {
+		t.running--
+	}
+	t.wakeUp()
+}
+
 type Limiter interface {
 	SetBurst(newBurst int)
 	SetLimit(newLimit rate.Limit)
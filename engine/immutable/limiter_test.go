@@ -17,11 +17,14 @@ limitations under the License.
 package immutable
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/stretchr/testify/require"
 )
 
 type MockNameWriterCloser struct{}
@@ -180,3 +183,50 @@ func TestSnapshotLimit(t *testing.T) {
 		t.Fatalf("write rate error, exp > 70, but speed = %v", speed)
 	}
 }
+
+func TestCompactionLimiter(t *testing.T) {
+	l := NewCompactionLimiter()
+	l.SetConcurrency(1, 2)
+
+	var running, peak int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, l.Acquire(context.Background(), 1))
+			n := atomic.AddInt32(&running, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			l.Release(1)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), peak)
+
+	// other levels are not limited
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.Acquire(context.Background(), 2))
+	}
+
+	// a canceled context aborts the acquire
+	require.NoError(t, l.Acquire(context.Background(), 1))
+	require.NoError(t, l.Acquire(context.Background(), 1))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, l.Acquire(ctx, 1))
+
+	// raising the limit wakes up the waiters
+	done := make(chan error)
+	go func() {
+		done <- l.Acquire(context.Background(), 1)
+	}()
+	l.SetConcurrency(1, 3)
+	require.NoError(t, <-done)
+}
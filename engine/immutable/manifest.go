@@ -0,0 +1,149 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+// tmpManifestFileSuffix is the suffix of the manifest being written,
+// it differs from the temporary tssp and tombstone files so RecoverTempFiles leaves it alone
+const tmpManifestFileSuffix = ".manifest.tmp"
+
+// ManifestEntry describes a tssp file recorded in a manifest
+type ManifestEntry struct {
+	Path     string `json:"path"`
+	Level    uint16 `json:"level"`
+	Sequence uint64 `json:"sequence"`
+	Size     int64  `json:"size"`
+	Version  uint64 `json:"version"`
+	Checksum string `json:"checksum"` // hex encoded sha256 of the whole file
+}
+
+// Manifest lists the files of a shard with their checksums, it is used to check the integrity of restored files.
+// The manifest is not signed: the checksums detect corrupted or truncated files,
+// but not a file changed together with the manifest
+type Manifest struct {
+	Files []ManifestEntry `json:"files"`
+}
+
+// WriteManifest records the files into a JSON manifest at path, closed files are skipped.
+// The files are referenced while their checksums are computed, so they are not removed by compaction meanwhile
+func (f *TSSPFiles) WriteManifest(path string, lock *string) error {
+	f.lock.RLock()
+	files := make([]TSSPFile, 0, len(f.files))
+	for _, tf := range f.files {
+		if fileStopped(tf) {
+			continue
+		}
+		tf.Ref()
+		files = append(files, tf)
+	}
+	f.lock.RUnlock()
+
+	defer func() {
+		for _, tf := range files {
+			tf.Unref()
+		}
+	}()
+
+	manifest := Manifest{Files: make([]ManifestEntry, 0, len(files))}
+	for _, tf := range files {
+		sum, err := fileChecksum(tf.Path())
+		if err != nil {
+			return err
+		}
+		level, seq := tf.LevelAndSequence()
+		manifest.Files = append(manifest.Files, ManifestEntry{
+			Path:     tf.Path(),
+			Level:    level,
+			Sequence: seq,
+			Size:     tf.FileSize(),
+			Version:  tf.Version(),
+			Checksum: sum,
+		})
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	lockOpt := fileops.FileLockOption(*lock)
+	tmp := path + tmpManifestFileSuffix
+	if err = fileops.WriteFile(tmp, data, 0640, lockOpt); err != nil {
+		return err
+	}
+	if err = fileops.RenameFile(tmp, path, lockOpt); err != nil {
+		_ = fileops.Remove(tmp, lockOpt)
+		return err
+	}
+	return nil
+}
+
+// VerifyManifest checks the files recorded in the manifest at path,
+// and returns the paths of the files that are missing or whose size or checksum does not match
+func VerifyManifest(path string) ([]string, error) {
+	data, err := fileops.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	if err = json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %v", path, err)
+	}
+
+	var mismatched []string
+	for _, entry := range manifest.Files {
+		fi, err := fileops.Stat(entry.Path)
+		if err != nil || fi.Size() != entry.Size {
+			mismatched = append(mismatched, entry.Path)
+			continue
+		}
+
+		sum, err := fileChecksum(entry.Path)
+		if err != nil || sum != entry.Checksum {
+			mismatched = append(mismatched, entry.Path)
+		}
+	}
+	return mismatched, nil
+}
+
+func fileChecksum(name string) (string, error) {
+	lock := fileops.FileLockOption("")
+	pri := fileops.FilePriorityOption(fileops.IO_PRIORITY_LOW)
+	fd, err := fileops.Open(name, lock, pri)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = fd.Close()
+	}()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, fd); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
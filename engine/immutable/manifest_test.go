@@ -0,0 +1,97 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTSSPFiles_Manifest(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+	defer store.Close()
+
+	var idMinMax, tmMinMax MinMax
+	for seq := uint64(1); seq <= 3; seq++ {
+		ids, data := genMemTableData(seq*10, 5, 100, &idMinMax, &tmMinMax)
+		fileName := NewTSSPFileName(seq, 0, 0, 0, true, &lockPath)
+		msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+		for _, id := range ids {
+			require.NoError(t, msb.WriteData(id, data[id]))
+		}
+		store.AddTable(msb, true, false)
+	}
+	fs := store.tableFiles("mst", true)
+	require.Equal(t, 3, fs.Len())
+
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, fs.WriteManifest(path, &lockPath))
+
+	_, err := os.Stat(path + tmpManifestFileSuffix)
+	require.True(t, os.IsNotExist(err))
+	require.False(t, IsTempleFile(filepath.Base(path+tmpManifestFileSuffix)))
+	require.False(t, isTmpTombstoneFile(filepath.Base(path+tmpManifestFileSuffix)))
+
+	buf, err := fileops.ReadFile(path)
+	require.NoError(t, err)
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(buf, &manifest))
+	require.Equal(t, 3, len(manifest.Files))
+	for i, entry := range manifest.Files {
+		tf := fs.Files()[i]
+		level, seq := tf.LevelAndSequence()
+		require.Equal(t, tf.Path(), entry.Path)
+		require.Equal(t, level, entry.Level)
+		require.Equal(t, seq, entry.Sequence)
+		require.Equal(t, tf.FileSize(), entry.Size)
+		require.Equal(t, tf.Version(), entry.Version)
+		require.Equal(t, 64, len(entry.Checksum))
+	}
+
+	mismatched, err := VerifyManifest(path)
+	require.NoError(t, err)
+	require.Empty(t, mismatched)
+
+	// flip a byte of the data block, the size is unchanged
+	tampered := manifest.Files[1].Path
+	fd, err := os.OpenFile(tampered, os.O_RDWR, 0640)
+	require.NoError(t, err)
+	b := make([]byte, 1)
+	_, err = fd.ReadAt(b, 32)
+	require.NoError(t, err)
+	b[0] ^= 0xff
+	_, err = fd.WriteAt(b, 32)
+	require.NoError(t, err)
+	require.NoError(t, fd.Close())
+
+	mismatched, err = VerifyManifest(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{tampered}, mismatched)
+
+	_, err = VerifyManifest(filepath.Join(dir, "not_exists.json"))
+	require.Error(t, err)
+}
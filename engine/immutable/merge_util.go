@@ -19,13 +19,16 @@ package immutable
 import (
 	"fmt"
 	"math"
+	"path/filepath"
 	"runtime/debug"
 	"sort"
 	"sync"
 	"time"
 
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
 	"go.uber.org/zap"
 )
 
@@ -215,3 +218,71 @@ func newNilCol(size int, ref *record.Field) *record.ColVal {
 	}
 	return col
 }
+
+// MergeOrderedUnordered merges the unordered file into the ordered file and writes the result into a new ordered file at outPath,
+// outPath is in the form of <dir>/<measurement>/<tssp file name>. Rows are sorted by series id and time,
+// and on identical timestamps the values of the unordered file win, as they are written later
+func MergeOrderedUnordered(order, unordered TSSPFile, outPath string, lock *string) (TSSPFile, error) {
+	var fileName TSSPFileName
+	if err := fileName.ParseFileName(outPath); err != nil {
+		return nil, errno.NewError(errno.InvalidTsspFileName, outPath, err)
+	}
+	fileName.SetOrder(true)
+	fileName.lock = lock
+	if _, err := fileops.Stat(outPath); err == nil {
+		return nil, fmt.Errorf("file %s exists", outPath)
+	}
+
+	// the references are released by closing the iterators
+	for _, f := range []TSSPFile{order, unordered} {
+		f.Ref()
+		f.RefFileReader()
+	}
+	orderItr := NewChunkIterator(NewFileIterator(order, CLog))
+	unorderedItr := NewChunkIterator(NewFileIterator(unordered, CLog))
+	defer orderItr.Close()
+	defer unorderedItr.Close()
+
+	mstDir := filepath.Dir(outPath)
+	idCount := int(order.FileStat().idCount + unordered.FileStat().idCount)
+	size := int(order.FileSize() + unordered.FileSize())
+	msb := NewMsBuilder(filepath.Dir(mstDir), filepath.Base(mstDir), lock, NewConfig(), idCount, fileName,
+		util.Warm, nil, size)
+
+	f, err := mergeChunkIterators(msb, orderItr, unorderedItr)
+	if err != nil {
+		msb.removeEmptyFile()
+		return nil, err
+	}
+	return f, nil
+}
+
+func mergeChunkIterators(msb *MsBuilder, orderItr, unorderedItr *ChunkIterator) (TSSPFile, error) {
+	hasOrder, hasUnordered := orderItr.Next(), unorderedItr.Next()
+	for hasOrder || hasUnordered {
+		var err error
+		switch {
+		case !hasUnordered || (hasOrder && orderItr.GetSeriesID() < unorderedItr.GetSeriesID()):
+			err = msb.WriteData(orderItr.GetSeriesID(), orderItr.GetRecord())
+			hasOrder = orderItr.Next()
+		case !hasOrder || unorderedItr.GetSeriesID() < orderItr.GetSeriesID():
+			err = msb.WriteData(unorderedItr.GetSeriesID(), unorderedItr.GetRecord())
+			hasUnordered = unorderedItr.Next()
+		default:
+			merged := &record.Record{}
+			merged.MergeRecord(unorderedItr.GetRecord(), orderItr.GetRecord())
+			err = msb.WriteData(orderItr.GetSeriesID(), merged)
+			hasOrder, hasUnordered = orderItr.Next(), unorderedItr.Next()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, err := range []error{orderItr.err, unorderedItr.err} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return msb.NewTSSPFile(false)
+}
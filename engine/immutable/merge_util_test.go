@@ -17,6 +17,7 @@ limitations under the License.
 package immutable_test
 
 import (
+	"path/filepath"
 	"sort"
 	"testing"
 	"time"
@@ -25,6 +26,8 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -70,7 +73,7 @@ func TestMergeContext(t *testing.T) {
 }
 
 func TestColumnIterator(t *testing.T) {
-	defer beforeTest(t, 0)
+	defer beforeTest(t, 0)()
 
 	mh := NewMergeTestHelper(immutable.NewConfig())
 	defer mh.store.Close()
@@ -91,7 +94,7 @@ func TestColumnIterator(t *testing.T) {
 }
 
 func TestColumnIterator_Close(t *testing.T) {
-	defer beforeTest(t, 0)
+	defer beforeTest(t, 0)()
 
 	mh := NewMergeTestHelper(immutable.NewConfig())
 	defer mh.store.Close()
@@ -164,3 +167,84 @@ func (p *MockPerformer) Finish() error {
 	}
 	return p.err
 }
+
+func TestMergeOrderedUnordered(t *testing.T) {
+	dir := t.TempDir()
+	lockPath := ""
+	conf := immutable.NewConfig()
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Int, Name: "value"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	newRec := func(times []int64, value int64) *record.Record {
+		rec := record.NewRecordBuilder(schema)
+		for _, tm := range times {
+			rec.ColVals[0].AppendInteger(value)
+			rec.ColVals[1].AppendInteger(tm)
+		}
+		return rec
+	}
+	writeFile := func(seq uint64, order bool, data map[uint64]*record.Record) immutable.TSSPFile {
+		fileName := immutable.NewTSSPFileName(seq, 0, 0, 0, order, &lockPath)
+		msb := immutable.NewMsBuilder(dir, "mst", &lockPath, conf, len(data), fileName, util.Warm, nil, 2)
+		sids := make([]uint64, 0, len(data))
+		for sid := range data {
+			sids = append(sids, sid)
+		}
+		sort.Slice(sids, func(i, j int) bool { return sids[i] < sids[j] })
+		for _, sid := range sids {
+			require.NoError(t, msb.WriteData(sid, data[sid]))
+		}
+		f, err := msb.NewTSSPFile(false)
+		require.NoError(t, err)
+		return f
+	}
+
+	order := writeFile(1, true, map[uint64]*record.Record{
+		1: newRec([]int64{1, 2, 3, 4}, 1),
+		2: newRec([]int64{1, 2, 3}, 1),
+		4: newRec([]int64{10, 20}, 1),
+	})
+	unordered := writeFile(2, false, map[uint64]*record.Record{
+		2: newRec([]int64{0, 2, 3, 5}, 2),
+		3: newRec([]int64{7}, 2),
+		4: newRec([]int64{15}, 2),
+	})
+	defer util.MustClose(order)
+	defer util.MustClose(unordered)
+
+	out := filepath.Join(dir, "mst", "00000003-0000-00000000.tssp")
+	merged, err := immutable.MergeOrderedUnordered(order, unordered, out, &lockPath)
+	require.NoError(t, err)
+	defer util.MustClose(merged)
+	require.Equal(t, out, merged.Path())
+	require.True(t, merged.IsOrder())
+
+	type row struct{ time, value int64 }
+	exp := map[uint64][]row{
+		1: {{1, 1}, {2, 1}, {3, 1}, {4, 1}},
+		2: {{0, 2}, {1, 1}, {2, 2}, {3, 2}, {5, 2}},
+		3: {{7, 2}},
+		4: {{10, 1}, {15, 2}, {20, 1}},
+	}
+	got := make(map[uint64][]row)
+	merged.Ref()
+	merged.RefFileReader()
+	itr := immutable.NewChunkIterator(immutable.NewFileIterator(merged, logger.NewLogger(errno.ModuleUnknown)))
+	var sids []uint64
+	for itr.Next() {
+		sid, rec := itr.GetSeriesID(), itr.GetRecord()
+		sids = append(sids, sid)
+		values := rec.Column(0).IntegerValues()
+		for i, tm := range rec.Times() {
+			got[sid] = append(got[sid], row{tm, values[i]})
+		}
+	}
+	itr.Close()
+	require.Equal(t, []uint64{1, 2, 3, 4}, sids)
+	require.Equal(t, exp, got)
+
+	// the output file exists
+	_, err = immutable.MergeOrderedUnordered(order, unordered, out, &lockPath)
+	require.Error(t, err)
+}
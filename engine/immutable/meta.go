@@ -430,6 +430,16 @@ func (m *ChunkMeta) columnIndex(ref *record.Field) int {
 	return -1
 }
 
+// columnIndexByName returns the index of the field column named name, the time column is excluded
+func (m *ChunkMeta) columnIndexByName(name string) int {
+	for i := 0; i < len(m.colMeta)-1; i++ {
+		if m.colMeta[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func (m *ChunkMeta) allRowsInRange(tr record.TimeRange) bool {
 	min, max := m.MinMaxTime()
 	return tr.Min <= min && tr.Max >= max
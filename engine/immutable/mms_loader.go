@@ -17,6 +17,7 @@ limitations under the License.
 package immutable
 
 import (
+	"os"
 	"path/filepath"
 	"sync"
 	"sync/atomic"
@@ -88,6 +89,14 @@ func (fl *fileLoader) Load(dir, mst string, isOrder bool) {
 }
 
 func (fl *fileLoader) loadFile(file, mst string, isOrder bool) {
+	if dataFile, ok := tombstoneDataFile(file); ok {
+		// tombstone files are loaded along with their tssp file, those left by a removed file are garbage
+		if _, err := fileops.Stat(dataFile); isTmpTombstoneFile(file) || os.IsNotExist(err) {
+			fl.removeFile(file)
+		}
+		return
+	}
+
 	if IsTempleFile(file) {
 		fl.removeFile(file)
 		return
@@ -130,9 +139,53 @@ func (fl *fileLoader) removeFile(file string) {
 	fl.lg.Info("remove file", zap.String("path", file), zap.Error(err))
 }
 
+// RecoverTempFiles removes the temporary tssp and tombstone files left in the tssp directory,
+// such as those produced by a compaction interrupted by a crash,
+// and returns the paths of the files that have been removed
+func RecoverTempFiles(dir string, lock *string) ([]string, error) {
+	items, err := fileops.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var reclaimed []string
+	lockOpt := fileops.FileLockOption("")
+	if lock != nil {
+		lockOpt = fileops.FileLockOption(*lock)
+	}
+	for _, item := range items {
+		file := filepath.Join(dir, item.Name())
+		if item.IsDir() {
+			files, err := RecoverTempFiles(file, lock)
+			reclaimed = append(reclaimed, files...)
+			if err != nil {
+				return reclaimed, err
+			}
+			continue
+		}
+
+		if !IsTempleFile(item.Name()) && !isTmpTombstoneFile(item.Name()) {
+			continue
+		}
+
+		if err := fileops.Remove(file, lockOpt); err != nil && !os.IsNotExist(err) {
+			return reclaimed, errRemoveFail(file, err)
+		}
+		reclaimed = append(reclaimed, file)
+	}
+
+	return reclaimed, nil
+}
+
 func (fl *fileLoader) openFile(file, mst string, isOrder bool) {
 	cacheData := fl.mst.cacheFileData()
 	f, err := OpenTSSPFile(file, fl.mst.lock, isOrder, cacheData)
+	if errno.Equal(err, errno.TsspFileVersionIncompatible) {
+		// keep the file on disk for the binary which can read it, but do not load it
+		fl.lg.Warn("skip the tssp file of incompatible version", zap.Error(err), zap.String("file", file))
+		fl.ctx.setError(err)
+		return
+	}
 	if err != nil || f == nil {
 		fl.lg.Error("open file failed", zap.Error(err), zap.String("file", file))
 		fl.ctx.setError(err)
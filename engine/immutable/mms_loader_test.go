@@ -42,13 +42,76 @@ func TestMmsLoader(t *testing.T) {
 	require.NoError(t, os.MkdirAll(path.Join(dir, "mst", unorderedDir, unorderedDir), 0700))
 	require.NoError(t, os.WriteFile(path.Join(dir, "mst", "00000001-0000-00000000.tssp.init"), []byte{1}, 0600))
 
+	// tombstones of a removed file and temporary tombstones are removed, the others are left to their file
+	kept := path.Join(dir, "mst", "00000002-0000-00000000.tssp.0.tomb")
+	removed := []string{
+		path.Join(dir, "mst", "00000001-0000-00000000.tssp.0.tomb"),
+		path.Join(dir, "mst", "00000002-0000-00000000.tssp.1.tomb.tmp"),
+	}
+	require.NoError(t, os.WriteFile(path.Join(dir, "mst", "00000002-0000-00000000.tssp"), []byte{1}, 0600))
+	for _, f := range append(removed, kept) {
+		require.NoError(t, os.WriteFile(f, []byte{1}, 0600))
+	}
+
+	// files are only removed once the store is not pre-loading
+	lockPath := path.Join(dir, "lock")
 	ctx = &fileLoadContext{}
 	loader = newFileLoader(&MmsTables{
-		lock:   &lock,
-		closed: make(chan struct{}),
+		lock:    &lockPath,
+		closed:  make(chan struct{}),
+		fileSeq: 2,
 	}, ctx)
 	loader.Load(path.Join(dir, "mst"), "mst", true)
 	loader.Wait()
 	_, err = ctx.getError()
 	require.NoError(t, err)
+
+	_, err = os.Stat(kept)
+	require.NoError(t, err)
+	for _, f := range removed {
+		_, err = os.Stat(f)
+		require.True(t, os.IsNotExist(err), f)
+	}
+}
+
+func TestRecoverTempFiles(t *testing.T) {
+	lock := ""
+	dir := t.TempDir()
+	mstDir := path.Join(dir, "mst_0000")
+	require.NoError(t, os.MkdirAll(path.Join(mstDir, unorderedDir), 0700))
+
+	valid := []string{
+		path.Join(mstDir, "00000001-0000-00000000.tssp"),
+		path.Join(mstDir, unorderedDir, "00000002-0000-00000000.tssp"),
+	}
+	stale := []string{
+		path.Join(mstDir, "00000003-0001-00000000.tssp.init"),
+		path.Join(mstDir, unorderedDir, "00000004-0000-00000000.tssp.init"),
+		path.Join(mstDir, "00000001-0000-00000000.tssp.0.tomb.tmp"),
+	}
+	for _, f := range append(valid, stale...) {
+		require.NoError(t, os.WriteFile(f, []byte{1}, 0600))
+	}
+
+	reclaimed, err := RecoverTempFiles(dir, &lock)
+	require.NoError(t, err)
+	require.ElementsMatch(t, stale, reclaimed)
+
+	for _, f := range valid {
+		_, err = os.Stat(f)
+		require.NoError(t, err)
+	}
+	for _, f := range stale {
+		_, err = os.Stat(f)
+		require.True(t, os.IsNotExist(err))
+	}
+
+	// the lock is optional
+	require.NoError(t, os.WriteFile(stale[0], []byte{1}, 0600))
+	reclaimed, err = RecoverTempFiles(dir, nil)
+	require.NoError(t, err)
+	require.Equal(t, stale[:1], reclaimed)
+
+	_, err = RecoverTempFiles(path.Join(dir, "not_exists"), &lock)
+	require.NotEmpty(t, err)
 }
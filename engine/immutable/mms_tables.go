@@ -18,6 +18,8 @@ package immutable
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -502,6 +504,7 @@ func (ctx *memReaderEvictCtx) runEvictMemReaders() {
 		if evictSize > 0 {
 			ctx.evictMemReader(evictSize)
 		}
+		ctx.enforceLevelMemBudgets()
 	}
 	timer.Stop()
 }
@@ -665,26 +668,42 @@ func (m *MmsTables) ReplaceDownSampleFiles(mstNames []string, originFiles [][]TS
 
 func RenameTmpFiles(newFiles []TSSPFile) error {
 	for i := range newFiles {
-		f := newFiles[i]
-		tmpName := f.Path()
-		if IsTempleFile(filepath.Base(tmpName)) {
-			fname := tmpName[:len(tmpName)-len(tmpTsspFileSuffix)]
-			if err := f.FreeFileHandle(); err != nil {
-				return err
-			}
-			if err := f.Rename(fname); err != nil {
-				log.Error("rename file error", zap.String("name", tmpName), zap.Error(err))
-				if _, e := fileops.Stat(fname); e != nil {
-					return os.ErrNotExist
-				}
-				return err
-			}
+		if err := PromoteTempFile(newFiles[i]); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// PromoteTempFile renames a temporary file to its final name, which makes it visible.
+// The trailer of the file is verified against the file length first,
+// a truncated file is left in place and an error is returned
+func PromoteTempFile(f TSSPFile) error {
+	tmpName := f.Path()
+	if !IsTempleFile(filepath.Base(tmpName)) {
+		return nil
+	}
+
+	if err := verifyFileSize(tmpName); err != nil {
+		log.Error("verify file error", zap.String("name", tmpName), zap.Error(err))
+		return err
+	}
+
+	fname := tmpName[:len(tmpName)-len(tmpTsspFileSuffix)]
+	if err := f.FreeFileHandle(); err != nil {
+		return err
+	}
+	if err := f.Rename(fname); err != nil {
+		log.Error("rename file error", zap.String("name", tmpName), zap.Error(err))
+		if _, e := fileops.Stat(fname); e != nil {
+			return os.ErrNotExist
+		}
+		return err
+	}
+	return nil
+}
+
 func (m *MmsTables) FreeAllMemReader() {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -755,10 +774,31 @@ func (m *MmsTables) mmsPlan(name string, files *TSSPFiles, level uint16, minGrou
 		return plans
 	}
 
+	walkLevelGroups(files, level, minGroupFileN, func(seqMap *dictpool.Dict) {
+		plan := m.genCompactGroup(seqMap, name, level)
+		if plan != nil {
+			plan.dropping = &files.closing
+			plans = append(plans, plan)
+		}
+	})
+
+	return plans
+}
+
+// walkLevelGroups calls fn with each group of at least minGroupFileN files of the level,
+// the files of a group have different sequences and are the values of seqMap
+func walkLevelGroups(files *TSSPFiles, level uint16, minGroupFileN int, fn func(seqMap *dictpool.Dict)) {
 	seqMap := seqMapPool.Get().(*dictpool.Dict)
 	seqMap.Reset()
 	defer seqMapPool.Put(seqMap)
 
+	flush := func() {
+		if seqMap.Len() >= minGroupFileN {
+			fn(seqMap)
+			seqMap.Reset()
+		}
+	}
+
 	idx := 0
 	for idx < files.Len() {
 		f := files.files[idx]
@@ -766,7 +806,7 @@ func (m *MmsTables) mmsPlan(name string, files *TSSPFiles, level uint16, minGrou
 		if lv != level {
 			// if seqMap.Len() >= minGroupFileN, but the next file is another level, we will create the plan
 			// and reserve the split file check logic
-			plans = m.genCompactPlan(seqMap, minGroupFileN, name, level, files, plans)
+			flush()
 			seqMap.Reset()
 			idx++
 			continue
@@ -774,7 +814,7 @@ func (m *MmsTables) mmsPlan(name string, files *TSSPFiles, level uint16, minGrou
 
 		seqByte := record.Uint64ToBytesUnsafe(seq)
 		if !seqMap.HasBytes(seqByte) {
-			plans = m.genCompactPlan(seqMap, minGroupFileN, name, level, files, plans)
+			flush()
 			seqMap.SetBytes(seqByte, f)
 			idx++
 		} else {
@@ -792,9 +832,7 @@ func (m *MmsTables) mmsPlan(name string, files *TSSPFiles, level uint16, minGrou
 
 	}
 
-	plans = m.genCompactPlan(seqMap, minGroupFileN, name, level, files, plans)
-
-	return plans
+	flush()
 }
 
 func (m *MmsTables) LevelPlan(level uint16) []*CompactGroup {
@@ -820,19 +858,91 @@ func (m *MmsTables) LevelPlan(level uint16) []*CompactGroup {
 	return plans
 }
 
-func (m *MmsTables) genCompactPlan(seqMap *dictpool.Dict, minGroupFileN int, name string, level uint16,
-	files *TSSPFiles, plans []*CompactGroup) []*CompactGroup {
-	if seqMap.Len() >= minGroupFileN {
-		plan := m.genCompactGroup(seqMap, name, level)
-		if plan != nil {
-			plan.dropping = &files.closing
-			plans = append(plans, plan)
+// PlanCompaction returns the groups the level compaction would compact the files into,
+// for the levels whose files are compacted into a level not above maxLevel.
+// It is a dry run, the files are neither changed nor referenced, and not marked as in compaction
+func PlanCompaction(files *TSSPFiles, maxLevel uint16) []*CompactGroup {
+	var plans []*CompactGroup
+	walkCompactionPlans(files, maxLevel, func(toLevel uint16, seqMap *dictpool.Dict) {
+		group := &CompactGroup{
+			toLevel:  toLevel,
+			group:    make([]string, seqMap.Len()),
+			dropping: &files.closing,
 		}
-		seqMap.Reset()
-	}
+		for i, kv := range seqMap.D {
+			group.group[i] = kv.Value.(TSSPFile).Path()
+		}
+		group.name = filepath.Base(filepath.Dir(group.group[0]))
+		plans = append(plans, group)
+	})
 	return plans
 }
 
+// CompactPlanReport describes a group planned by PlanCompactionReport
+type CompactPlanReport struct {
+	Files   []string
+	ToLevel uint16
+	// EstimateSize is the sum of the sizes of the input files, which is used as FilesInfo.EstimateSize
+	EstimateSize int
+}
+
+// PlanCompactionReport is like PlanCompaction, and reports the estimated output size of each group.
+// It does not change any file state or reference count, so it can be used on a live shard
+func PlanCompactionReport(files *TSSPFiles, maxLevel uint16) []CompactPlanReport {
+	var reports []CompactPlanReport
+	walkCompactionPlans(files, maxLevel, func(toLevel uint16, seqMap *dictpool.Dict) {
+		report := CompactPlanReport{
+			Files:   make([]string, seqMap.Len()),
+			ToLevel: toLevel,
+		}
+		for i, kv := range seqMap.D {
+			f := kv.Value.(TSSPFile)
+			report.Files[i] = f.Path()
+			report.EstimateSize += int(f.FileSize())
+		}
+		reports = append(reports, report)
+	})
+	return reports
+}
+
+func walkCompactionPlans(files *TSSPFiles, maxLevel uint16, fn func(toLevel uint16, seqMap *dictpool.Dict)) {
+	if files.fullCompacted() {
+		return
+	}
+
+	files.lock.RLock()
+	defer files.lock.RUnlock()
+
+	for level := uint16(0); level < CompactLevels && level < maxLevel; level++ {
+		minGroupFileN := LeveLMinGroupFiles[level]
+		if files.Len() < minGroupFileN {
+			continue
+		}
+
+		walkLevelGroups(files, level, minGroupFileN, func(seqMap *dictpool.Dict) {
+			fn(level+1, seqMap)
+		})
+	}
+}
+
+// WriteCompactPlanReports writes the reports in a human readable form, one group after another
+func WriteCompactPlanReports(w io.Writer, reports []CompactPlanReport) error {
+	for i := range reports {
+		r := &reports[i]
+		_, err := fmt.Fprintf(w, "group %d: %d files to level %d, estimated size %d bytes\n",
+			i, len(r.Files), r.ToLevel, r.EstimateSize)
+		if err != nil {
+			return err
+		}
+		for _, name := range r.Files {
+			if _, err = fmt.Fprintf(w, "\t%s\n", name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (m *MmsTables) File(mstName string, fileName string, isOrder bool) TSSPFile {
 	files, ok := m.getTSSPFiles(mstName, isOrder)
 	if !ok || files == nil {
@@ -17,6 +17,8 @@ limitations under the License.
 package immutable
 
 import (
+	"sync"
+
 	"github.com/openGemini/openGemini/engine/comm"
 	"github.com/openGemini/openGemini/engine/immutable/encoding"
 	"github.com/openGemini/openGemini/lib/bufferpool"
@@ -99,6 +101,56 @@ func (d *ReadContext) Release() {
 	}
 }
 
+// reset clears the state of the last read but keeps the buffers and the coders for reuse
+func (d *ReadContext) reset() {
+	d.decBuf = d.decBuf[:0]
+	d.offset = d.offset[:0]
+	d.col.Init()
+	d.ops = nil
+	d.tr = record.MinMaxTimeRange
+	d.Ascending = true
+	d.onlyFirstOrLast = false
+	d.origData = nil
+
+	if d.preAggBuilders == nil {
+		d.preAggBuilders = newPreAggBuilders()
+	} else {
+		d.preAggBuilders.reset()
+	}
+}
+
+var readContextPool = sync.Pool{}
+
+// AcquireReadContext returns an ascending ReadContext from the pool,
+// it should be put back by ReleaseReadContext once the reads are done
+func AcquireReadContext() *ReadContext {
+	ctx, ok := readContextPool.Get().(*ReadContext)
+	if !ok {
+		return NewReadContext(true)
+	}
+
+	if !mmapEn && ctx.readBuf == nil {
+		ctx.readBuf = bufferpool.Get()
+	}
+	return ctx
+}
+
+// ReleaseReadContext resets ctx and puts it back to the pool, ctx must not be used after that
+func ReleaseReadContext(ctx *ReadContext) {
+	if ctx == nil {
+		return
+	}
+
+	if ctx.coderCtx == nil || ctx.decBuf == nil {
+		// the buffers have been released by ctx.Release
+		ctx.Release()
+		return
+	}
+
+	ctx.reset()
+	readContextPool.Put(ctx)
+}
+
 func (d *ReadContext) SetTr(tr record.TimeRange) {
 	d.tr = tr
 }
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/comm"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadContextReset(t *testing.T) {
+	ctx := AcquireReadContext()
+	require.True(t, ctx.Ascending)
+	require.Equal(t, record.MinMaxTimeRange, ctx.tr)
+
+	ctx.Set(false, record.TimeRange{Min: 1, Max: 2}, true, []*comm.CallOption{{}})
+	ctx.decBuf = append(ctx.decBuf, 1, 2, 3)
+	ctx.offset = append(ctx.offset, 1, 2)
+	ctx.col.AppendInteger(1)
+	ctx.origData = []byte{1}
+
+	ctx.reset()
+	require.True(t, ctx.Ascending)
+	require.False(t, ctx.onlyFirstOrLast)
+	require.False(t, ctx.MatchPreAgg())
+	require.Equal(t, record.MinMaxTimeRange, ctx.tr)
+	require.Empty(t, ctx.decBuf)
+	require.Empty(t, ctx.offset)
+	require.Equal(t, 0, ctx.col.Len)
+	require.Empty(t, ctx.col.Val)
+	require.Nil(t, ctx.origData)
+	require.NotNil(t, ctx.coderCtx)
+	require.NotNil(t, ctx.preAggBuilders)
+	ReleaseReadContext(ctx)
+
+	// the context released by Release is not put back to the pool
+	ctx = AcquireReadContext()
+	ctx.Release()
+	ReleaseReadContext(ctx)
+	ReleaseReadContext(nil)
+}
+
+func BenchmarkReadContext(b *testing.B) {
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ctx := NewReadContext(true)
+			ctx.Release()
+		}
+	})
+
+	b.Run("pool", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ctx := AcquireReadContext()
+			ReleaseReadContext(ctx)
+		}
+	})
+}
@@ -167,6 +167,10 @@ func (m MocTsspFile) FreeFileHandle() error {
 	return nil
 }
 
+func (m MocTsspFile) Reopen() error {
+	return nil
+}
+
 func (m MocTsspFile) Stop() {
 	return
 }
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"sort"
+
+	"github.com/openGemini/openGemini/lib/record"
+)
+
+// SegmentIterator iterates the segments of a chunk
+type SegmentIterator interface {
+	// Next returns the rows of the next segment, the returned record is reused by the following call.
+	// nil is returned if there are no more segments
+	Next() (*record.Record, error)
+}
+
+// segmentRangeIterator reads the segments of a chunk which overlap with tr one by one,
+// rows out of tr in the first and the last segments are dropped
+type segmentRangeIterator struct {
+	f       TSSPFile
+	cm      *ChunkMeta
+	tr      record.TimeRange
+	ctx     *ReadContext
+	fields  record.Schemas
+	segment int
+
+	rec *record.Record
+	dst *record.Record
+}
+
+func newSegmentRangeIterator(f TSSPFile, cm *ChunkMeta, tr record.TimeRange, ctx *ReadContext) *segmentRangeIterator {
+	itr := &segmentRangeIterator{
+		f:      f,
+		cm:     cm,
+		tr:     tr,
+		ctx:    ctx,
+		fields: make(record.Schemas, len(cm.colMeta)),
+		rec:    &record.Record{},
+		dst:    &record.Record{},
+	}
+	for i := range cm.colMeta {
+		itr.fields[i].Name = cm.colMeta[i].name
+		itr.fields[i].Type = int(cm.colMeta[i].ty)
+	}
+	return itr
+}
+
+func (itr *segmentRangeIterator) Next() (*record.Record, error) {
+	for itr.segment < itr.cm.segmentCount() {
+		i := itr.segment
+		itr.segment++
+
+		sr := &itr.cm.timeRange[i]
+		if !itr.tr.Overlaps(sr.minTime(), sr.maxTime()) {
+			continue
+		}
+
+		itr.rec.Reset()
+		itr.rec.SetSchema(itr.fields)
+		itr.rec.ReserveColVal(len(itr.fields))
+		rec, err := itr.f.ReadAt(itr.cm, i, itr.rec, itr.ctx)
+		if err != nil {
+			return nil, err
+		}
+		itr.rec = rec
+
+		if itr.tr.Min <= sr.minTime() && itr.tr.Max >= sr.maxTime() {
+			return rec, nil
+		}
+
+		times := rec.Times()
+		start := sort.Search(len(times), func(j int) bool { return times[j] >= itr.tr.Min })
+		end := sort.Search(len(times), func(j int) bool { return times[j] > itr.tr.Max })
+		if start >= end {
+			continue
+		}
+		itr.dst.SliceFromRecord(rec, start, end)
+		return itr.dst, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTSSPFile_ReadRange(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	conf.SetMaxRowsPerSegment(16)
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 1, 100, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	require.NoError(t, msb.WriteData(ids[0], data[ids[0]]))
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+	tf, ok := fs.Files()[0].(*tsspFile)
+	require.True(t, ok)
+
+	mi, err := tf.MetaIndexAt(0)
+	require.NoError(t, err)
+	metas, err := tf.ReadChunkMetaData(0, mi, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(metas))
+	cm := &metas[0]
+	require.Equal(t, 7, cm.segmentCount())
+
+	times := data[ids[0]].Times()
+	readRange := func(tr record.TimeRange) ([]int64, int) {
+		itr, err := tf.ReadRange(cm, tr, NewReadContext(true))
+		require.NoError(t, err)
+
+		var got []int64
+		segments := 0
+		for {
+			rec, err := itr.Next()
+			require.NoError(t, err)
+			if rec == nil {
+				break
+			}
+			segments++
+			require.Equal(t, len(cm.colMeta), rec.ColNums())
+			got = append(got, rec.Times()...)
+		}
+		return got, segments
+	}
+
+	// rows [20, 50] are in the segments 1, 2 and 3
+	got, segments := readRange(record.TimeRange{Min: times[20], Max: times[50]})
+	require.Equal(t, times[20:51], got)
+	require.Equal(t, 3, segments)
+
+	got, segments = readRange(record.TimeRange{Min: times[16], Max: times[31]})
+	require.Equal(t, times[16:32], got)
+	require.Equal(t, 1, segments)
+
+	got, segments = readRange(record.MinMaxTimeRange)
+	require.Equal(t, times, got)
+	require.Equal(t, 7, segments)
+
+	got, segments = readRange(record.TimeRange{Min: times[99] + 1, Max: times[99] + 10})
+	require.Empty(t, got)
+	require.Equal(t, 0, segments)
+
+	tf.Stop()
+	_, err = tf.ReadRange(cm, record.MinMaxTimeRange, NewReadContext(true))
+	require.Equal(t, errFileClosed, err)
+}
+
+func TestTSSPFile_EstimateRows(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	conf.SetMaxRowsPerSegment(16)
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 5, 100, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	for _, id := range ids {
+		require.NoError(t, msb.WriteData(id, data[id]))
+	}
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+	tf, ok := fs.Files()[0].(*tsspFile)
+	require.True(t, ok)
+
+	readRows := func(id uint64, tr record.TimeRange) int64 {
+		idx, mi, err := tf.MetaIndex(id, tr)
+		require.NoError(t, err)
+		var buf []byte
+		cm, err := tf.ChunkMeta(id, mi.offset, mi.size, mi.count, idx, &ChunkMeta{}, &buf)
+		require.NoError(t, err)
+		itr, err := tf.ReadRange(cm, tr, NewReadContext(true))
+		require.NoError(t, err)
+
+		var n int64
+		for {
+			rec, err := itr.Next()
+			require.NoError(t, err)
+			if rec == nil {
+				return n
+			}
+			n += int64(rec.RowNums())
+		}
+	}
+
+	for _, id := range ids {
+		times := data[id].Times()
+		ranges := []record.TimeRange{
+			{Min: times[0], Max: times[len(times)-1]},
+			{Min: times[0] - 100, Max: times[len(times)-1] + 100},
+			{Min: times[20], Max: times[50]},
+			{Min: times[17], Max: times[17]},
+			{Min: times[90], Max: times[len(times)-1] + 100},
+		}
+		for _, tr := range ranges {
+			exp := readRows(id, tr)
+			got, err := tf.EstimateRows(id, tr)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, got, exp, "id %d, range %v", id, tr)
+			// at most the rows of the two boundary segments are overestimated
+			require.LessOrEqual(t, got-exp, int64(2*16), "id %d, range %v", id, tr)
+		}
+
+		got, err := tf.EstimateRows(id, record.TimeRange{Min: times[len(times)-1] + 1, Max: times[len(times)-1] + 100})
+		require.NoError(t, err)
+		require.Equal(t, int64(0), got)
+	}
+
+	got, err := tf.EstimateRows(ids[len(ids)-1]+100, record.TimeRange{Min: 0, Max: 1 << 62})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), got)
+
+	tf.Stop()
+	_, err = tf.EstimateRows(ids[0], record.TimeRange{Min: 0, Max: 1 << 62})
+	require.Equal(t, errFileClosed, err)
+}
+
+func TestTSSPFile_ReadFields(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	conf.SetMaxRowsPerSegment(16)
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 1, 100, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	require.NoError(t, msb.WriteData(ids[0], data[ids[0]]))
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+	tf, ok := fs.Files()[0].(*tsspFile)
+	require.True(t, ok)
+
+	src := data[ids[0]]
+	times := src.Times()
+	tr := record.TimeRange{Min: times[20], Max: times[50]}
+	dst := &record.Record{}
+
+	fields := record.Schemas{
+		{Name: "field4_bool", Type: influx.Field_Type_Boolean},
+		{Name: "not_exists", Type: influx.Field_Type_String},
+		{Name: "field2_float", Type: influx.Field_Type_Float},
+		{Name: "field2_float", Type: influx.Field_Type_Float},
+	}
+	require.NoError(t, tf.ReadFields(ids[0], tr, fields, dst))
+	require.Equal(t, record.Schemas{
+		{Name: "field2_float", Type: influx.Field_Type_Float},
+		{Name: "field4_bool", Type: influx.Field_Type_Boolean},
+		{Name: "not_exists", Type: influx.Field_Type_String},
+		{Name: record.TimeField, Type: influx.Field_Type_Int},
+	}, dst.Schema)
+	require.Equal(t, 31, dst.RowNums())
+	require.Equal(t, times[20:51], dst.Times())
+
+	for i := 0; i < 2; i++ {
+		idx := src.Schema.FieldIndex(dst.Schema[i].Name)
+		exp := &record.ColVal{}
+		exp.AppendColVal(&src.ColVals[idx], src.Schema[idx].Type, 20, 51)
+		require.Equal(t, exp.Len, dst.ColVals[i].Len)
+		require.Equal(t, exp.NilCount, dst.ColVals[i].NilCount)
+		require.Equal(t, exp.Val, dst.ColVals[i].Val)
+	}
+	// the field missing in the file is a null column
+	require.Equal(t, 31, dst.ColVals[2].Len)
+	require.Equal(t, 31, dst.ColVals[2].NilCount)
+
+	// none of the fields is in the file
+	notExists := record.Schemas{{Name: "not_exists", Type: influx.Field_Type_Int}}
+	require.NoError(t, tf.ReadFields(ids[0], tr, notExists, dst))
+	require.Equal(t, 31, dst.RowNums())
+	require.Equal(t, notExists[0], dst.Schema[0])
+	require.Equal(t, 31, dst.ColVals[0].NilCount)
+	require.Equal(t, times[20:51], dst.Times())
+
+	// the type of the field in the file is not the requested one
+	require.Error(t, tf.ReadFields(ids[0], tr, record.Schemas{{Name: "field1_int64", Type: influx.Field_Type_Float}}, dst))
+
+	// out of the time range of the file
+	int64Field := record.Schemas{{Name: "field1_int64", Type: influx.Field_Type_Int}}
+	require.NoError(t, tf.ReadFields(ids[0], record.TimeRange{Min: times[99] + 1, Max: times[99] + 100}, int64Field, dst))
+	require.Equal(t, 0, dst.RowNums())
+	require.Equal(t, 2, len(dst.Schema))
+
+	tf.Stop()
+	require.Equal(t, errFileClosed, tf.ReadFields(ids[0], tr, int64Field, dst))
+}
+
+// writeWideFile writes one series with cols float fields into a new file and returns the file and the record written
+func writeWideFile(tb testing.TB, dir string, cols, rows int) (*tsspFile, *record.Record, func()) {
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	schema := make(record.Schemas, 0, cols+1)
+	for i := 0; i < cols; i++ {
+		schema = append(schema, record.Field{Name: fmt.Sprintf("field%03d", i), Type: influx.Field_Type_Float})
+	}
+	schema = append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+	rec := record.NewRecordBuilder(schema)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			rec.Column(j).AppendFloat(float64(i*cols + j))
+		}
+		rec.TimeColumn().AppendInteger(int64(i + 1))
+	}
+
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	require.NoError(tb, msb.WriteData(1, rec))
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.Equal(tb, 1, fs.Len())
+	return fs.Files()[0].(*tsspFile), rec, func() { _ = store.Close() }
+}
+
+func TestTSSPFile_ReadAtColumns(t *testing.T) {
+	tf, src, release := writeWideFile(t, t.TempDir(), 8, 100)
+	defer release()
+
+	tr := record.TimeRange{Min: math.MinInt64, Max: math.MaxInt64}
+	tf.mu.RLock()
+	cm, err := tf.chunkMetaOf(1, tr)
+	tf.mu.RUnlock()
+	require.NoError(t, err)
+
+	decs := NewReadContext(true)
+	dst := &record.Record{}
+	rec, err := tf.ReadAtColumns(cm, 0, []string{"field005", "not_exists", record.TimeField, "field001"}, dst, decs)
+	require.NoError(t, err)
+	require.Equal(t, []string{"field001", "field005", record.TimeField},
+		[]string{rec.Schema[0].Name, rec.Schema[1].Name, rec.Schema[2].Name})
+	require.Equal(t, src.RowNums(), rec.RowNums())
+	require.Equal(t, src.Times(), rec.Times())
+	require.Equal(t, src.ColVals[1].Val, rec.ColVals[0].Val)
+	require.Equal(t, src.ColVals[5].Val, rec.ColVals[1].Val)
+
+	rec, err = tf.ReadAtColumns(cm, 0, []string{"not_exists"}, dst, decs)
+	require.NoError(t, err)
+	require.Nil(t, rec)
+
+	_, err = tf.ReadAtColumns(cm, cm.segmentCount(), []string{"field001"}, dst, decs)
+	require.Error(t, err)
+}
+
+func BenchmarkTSSPFile_ReadAtColumns(b *testing.B) {
+	tf, src, release := writeWideFile(b, b.TempDir(), 64, 1000)
+	defer release()
+
+	tr := record.TimeRange{Min: math.MinInt64, Max: math.MaxInt64}
+	tf.mu.RLock()
+	cm, err := tf.chunkMetaOf(1, tr)
+	tf.mu.RUnlock()
+	require.NoError(b, err)
+	decs := NewReadContext(true)
+
+	b.Run("full", func(b *testing.B) {
+		dst := &record.Record{}
+		for i := 0; i < b.N; i++ {
+			dst.ResetWithSchema(src.Schema)
+			if _, err := tf.ReadAt(cm, 0, dst, decs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("single", func(b *testing.B) {
+		dst := &record.Record{}
+		fields := []string{"field032"}
+		for i := 0; i < b.N; i++ {
+			if _, err := tf.ReadAtColumns(cm, 0, fields, dst, decs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
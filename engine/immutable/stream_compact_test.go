@@ -171,6 +171,19 @@ func TestFileIterator(t *testing.T) {
 		t.Fatalf("maxChunkRows, exp:%v, but:%v", recRows, fi.maxChunkRows)
 	}
 
+	if fi.AvgChunkRows() != fi.avgChunkRows || fi.MaxChunkRows() != fi.maxChunkRows ||
+		fi.MaxColumns() != fi.maxColumns || fi.MaxChunkN() != fi.maxChunkN {
+		t.Fatalf("FilesInfo getters mismatch")
+	}
+
+	var estimateSize int
+	for _, f := range fids.files {
+		estimateSize += int(f.FileSize())
+	}
+	if fi.EstimateSize() != estimateSize {
+		t.Fatalf("EstimateSize, exp:%v, but:%v", estimateSize, fi.EstimateSize())
+	}
+
 	SegMergeFlag(AutoCompact)
 	SegMergeFlag(NonStreamingCompact)
 	if NonStreamingCompaction(fi) != true {
@@ -17,6 +17,7 @@ limitations under the License.
 package immutable
 
 import (
+	"fmt"
 	"sync"
 	"unsafe"
 
@@ -34,6 +35,24 @@ const (
 	maxImmTablePercentage = 85
 )
 
+// readableVersions are the versions of the tssp files this binary can decode
+var readableVersions = []uint64{version}
+
+// checkFileVersion reports whether the tssp file of version ver can be decoded
+// by this binary, the returned reason describes why if it can not
+func checkFileVersion(ver uint64) (bool, string) {
+	for _, v := range readableVersions {
+		if v == ver {
+			return true, ""
+		}
+	}
+
+	if ver > version {
+		return false, fmt.Sprintf("file version %d is newer than the latest readable version %d", ver, version)
+	}
+	return false, fmt.Sprintf("file version %d is not readable, readable versions: %v", ver, readableVersions)
+}
+
 var (
 	falsePositive         = 0.08
 	nodeImmTableSizeLimit = int64(20 * 1024 * 1024 * 1024)
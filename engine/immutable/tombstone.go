@@ -16,7 +16,67 @@ limitations under the License.
 
 package immutable
 
-import "sync"
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+const (
+	tombstoneFileSuffix    = ".tomb"
+	tmpTombstoneFileSuffix = ".tmp"
+	tombstoneSize          = 24 // id, min time and max time
+)
+
+var tombstoneFileMagic = []byte("TSTOMB01")
+
+// SyncPolicy decides when tombstone files are synced to disk
+type SyncPolicy int32
+
+const (
+	// SyncAlways syncs the file after each write
+	SyncAlways SyncPolicy = iota
+	// SyncOnClose syncs the file once when it is closed
+	SyncOnClose
+	// SyncNever leaves the file to be synced by the os
+	SyncNever
+)
+
+var tombstoneSyncPolicy = int32(SyncAlways)
+
+// SetTombstoneSyncPolicy sets the policy of syncing tombstone files, the default is SyncAlways
+func SetTombstoneSyncPolicy(policy SyncPolicy) {
+	atomic.StoreInt32(&tombstoneSyncPolicy, int32(policy))
+}
+
+func getTombstoneSyncPolicy() SyncPolicy {
+	return SyncPolicy(atomic.LoadInt32(&tombstoneSyncPolicy))
+}
+
+// syncTombstoneFile is called by writeTombstoneFile after writing to fd and before closing fd,
+// closing is true for the latter. fd is synced according to the tombstone sync policy
+func syncTombstoneFile(fd fileops.File, closing bool) error {
+	switch getTombstoneSyncPolicy() {
+	case SyncAlways:
+		if closing {
+			// synced by the last write
+			return nil
+		}
+	case SyncOnClose:
+		if !closing {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return fd.Sync()
+}
 
 type Tombstone struct {
 	ID               uint64
@@ -43,3 +103,174 @@ func (t *TombstoneFile) TombstonesCount() int {
 
 	return len(t.tombstones)
 }
+
+// Tombstones returns a copy of the tombstones in the file
+func (t *TombstoneFile) Tombstones() []Tombstone {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return append([]Tombstone(nil), t.tombstones...)
+}
+
+func (t *TombstoneFile) clone() TombstoneFile {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return TombstoneFile{path: t.path, tombstones: append([]Tombstone(nil), t.tombstones...)}
+}
+
+// Compact coalesces the overlapping and adjacent time ranges of each id,
+// the tombstones are replaced under the lock so that concurrent readers see either set
+func (t *TombstoneFile) Compact() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tombstones = mergeTombstones(t.tombstones)
+}
+
+// mergeTombstones returns the minimal set of tombstones covering the same ranges as src,
+// sorted by id and min time. src is not modified
+func mergeTombstones(src []Tombstone) []Tombstone {
+	if len(src) == 0 {
+		return nil
+	}
+
+	ts := make([]Tombstone, len(src))
+	copy(ts, src)
+	sort.Slice(ts, func(i, j int) bool {
+		if ts[i].ID != ts[j].ID {
+			return ts[i].ID < ts[j].ID
+		}
+		return ts[i].MinTime < ts[j].MinTime
+	})
+
+	n := 0
+	for i := 1; i < len(ts); i++ {
+		last := &ts[n]
+		// MinTime-1 is compared instead of MaxTime+1, which overflows for math.MaxInt64
+		if ts[i].ID == last.ID && (ts[i].MinTime <= last.MaxTime || ts[i].MinTime-1 <= last.MaxTime) {
+			if ts[i].MaxTime > last.MaxTime {
+				last.MaxTime = ts[i].MaxTime
+			}
+			continue
+		}
+		n++
+		ts[n] = ts[i]
+	}
+	return ts[:n+1]
+}
+
+// tombstoneFilePath returns the path of the i-th tombstone file of the tssp file,
+// the tombstone files of a file are numbered from 0 without gaps. They are named after the file
+// without its temporary suffix, so they stay in place while the file is renamed to and from its temporary name
+func tombstoneFilePath(tsspPath string, i int) string {
+	if IsTempleFile(tsspPath) {
+		tsspPath = tsspPath[:len(tsspPath)-tmpSuffixNameLen]
+	}
+	return fmt.Sprintf("%s.%d%s", tsspPath, i, tombstoneFileSuffix)
+}
+
+// tombstoneDataFile returns the path of the tssp file that the tombstone file, or a temporary one, belongs to
+func tombstoneDataFile(name string) (string, bool) {
+	name = strings.TrimSuffix(name, tmpTombstoneFileSuffix)
+	if !strings.HasSuffix(name, tombstoneFileSuffix) {
+		return "", false
+	}
+	name = strings.TrimSuffix(name, tombstoneFileSuffix)
+	i := strings.LastIndexByte(name, '.')
+	if i <= 0 {
+		return "", false
+	}
+	return name[:i], true
+}
+
+func isTmpTombstoneFile(name string) bool {
+	return strings.HasSuffix(name, tombstoneFileSuffix+tmpTombstoneFileSuffix)
+}
+
+// createTombstoneFile creates the file written by writeTombstoneFile, it is replaced in tests
+var createTombstoneFile = func(name string, lock *string) (fileops.File, error) {
+	return fileops.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640, fileops.FileLockOption(*lock))
+}
+
+func marshalTombstones(ts []Tombstone) []byte {
+	n := len(tombstoneFileMagic)
+	buf := make([]byte, n+len(ts)*tombstoneSize)
+	copy(buf, tombstoneFileMagic)
+	for i := range ts {
+		b := buf[n+i*tombstoneSize:]
+		binary.BigEndian.PutUint64(b, ts[i].ID)
+		binary.BigEndian.PutUint64(b[8:], uint64(ts[i].MinTime))
+		binary.BigEndian.PutUint64(b[16:], uint64(ts[i].MaxTime))
+	}
+	return buf
+}
+
+func unmarshalTombstones(buf []byte) ([]Tombstone, error) {
+	n := len(tombstoneFileMagic)
+	if len(buf) < n || string(buf[:n]) != string(tombstoneFileMagic) || (len(buf)-n)%tombstoneSize != 0 {
+		return nil, fmt.Errorf("invalid tombstone file, size %d", len(buf))
+	}
+
+	buf = buf[n:]
+	ts := make([]Tombstone, len(buf)/tombstoneSize)
+	for i := range ts {
+		b := buf[i*tombstoneSize:]
+		ts[i].ID = binary.BigEndian.Uint64(b)
+		ts[i].MinTime = int64(binary.BigEndian.Uint64(b[8:]))
+		ts[i].MaxTime = int64(binary.BigEndian.Uint64(b[16:]))
+	}
+	return ts, nil
+}
+
+// writeTombstoneFile writes ts to a temporary file which is then renamed to path,
+// so a partially written file is never loaded. The file is synced according to the tombstone sync policy,
+// or always if forceSync is true
+func writeTombstoneFile(path string, ts []Tombstone, lock *string, forceSync bool) error {
+	tmp := path + tmpTombstoneFileSuffix
+	lockOpt := fileops.FileLockOption(*lock)
+	fd, err := createTombstoneFile(tmp, lock)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fd.Write(marshalTombstones(ts)); err == nil {
+		if forceSync {
+			err = fd.Sync()
+		} else if err = syncTombstoneFile(fd, false); err == nil {
+			err = syncTombstoneFile(fd, true)
+		}
+	}
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = fileops.RenameFile(tmp, path, lockOpt)
+	}
+	if err != nil {
+		_ = fileops.Remove(tmp, lockOpt)
+		return fmt.Errorf("write tombstone file %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadTombstoneFiles reads the tombstone files of the tssp file, stopping at the first missing one
+func loadTombstoneFiles(tsspPath string, lock *string) ([]TombstoneFile, error) {
+	var files []TombstoneFile
+	for i := 0; ; i++ {
+		path := tombstoneFilePath(tsspPath, i)
+		buf, err := fileops.ReadFile(path, fileops.FileLockOption(*lock))
+		if os.IsNotExist(err) {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ts, err := unmarshalTombstones(buf)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		files = append(files, TombstoneFile{path: path, tombstones: ts})
+	}
+}
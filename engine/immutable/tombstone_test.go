@@ -0,0 +1,427 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTombstones(t *testing.T) {
+	require.Empty(t, mergeTombstones(nil))
+
+	src := []Tombstone{
+		{ID: 2, MinTime: 50, MaxTime: 60},
+		{ID: 1, MinTime: 10, MaxTime: 20},
+		{ID: 1, MinTime: 15, MaxTime: 30},
+		{ID: 1, MinTime: 31, MaxTime: 40}, // adjacent
+		{ID: 1, MinTime: 12, MaxTime: 18}, // contained
+		{ID: 1, MinTime: 42, MaxTime: 50}, // gap
+		{ID: 2, MinTime: 0, MaxTime: 49},
+		{ID: 2, MinTime: 55, MaxTime: math.MaxInt64},
+		{ID: 3, MinTime: math.MinInt64, MaxTime: 0},
+		{ID: 3, MinTime: math.MinInt64, MaxTime: 10},
+	}
+	for i := int64(0); i < 100; i++ {
+		src = append(src, Tombstone{ID: 4, MinTime: i * 10, MaxTime: i*10 + 15})
+	}
+
+	exp := []Tombstone{
+		{ID: 1, MinTime: 10, MaxTime: 40},
+		{ID: 1, MinTime: 42, MaxTime: 50},
+		{ID: 2, MinTime: 0, MaxTime: math.MaxInt64},
+		{ID: 3, MinTime: math.MinInt64, MaxTime: 10},
+		{ID: 4, MinTime: 0, MaxTime: 1005},
+	}
+	n := len(src)
+	require.Equal(t, exp, mergeTombstones(src))
+	require.Equal(t, n, len(src))
+	require.Equal(t, Tombstone{ID: 2, MinTime: 50, MaxTime: 60}, src[0])
+
+	tf := &TombstoneFile{tombstones: src}
+	tf.Compact()
+	require.Equal(t, len(exp), tf.TombstonesCount())
+	require.Equal(t, exp, tf.tombstones)
+}
+
+type syncCountFile struct {
+	fileops.File
+	syncs int
+}
+
+func (f *syncCountFile) Sync() error {
+	f.syncs++
+	return nil
+}
+
+func TestTombstoneSyncPolicy(t *testing.T) {
+	require.Equal(t, SyncAlways, getTombstoneSyncPolicy())
+	defer SetTombstoneSyncPolicy(SyncAlways)
+
+	writeAndClose := func(writes int) int {
+		fd := &syncCountFile{}
+		for i := 0; i < writes; i++ {
+			require.NoError(t, syncTombstoneFile(fd, false))
+		}
+		require.NoError(t, syncTombstoneFile(fd, true))
+		return fd.syncs
+	}
+
+	require.Equal(t, 3, writeAndClose(3))
+
+	SetTombstoneSyncPolicy(SyncOnClose)
+	require.Equal(t, 1, writeAndClose(3))
+
+	SetTombstoneSyncPolicy(SyncNever)
+	require.Equal(t, 0, writeAndClose(3))
+}
+
+func TestTombstoneSyncPolicy_WriteTombstones(t *testing.T) {
+	defer SetTombstoneSyncPolicy(SyncAlways)
+	files := writeBlockCacheTestFiles(t, t.TempDir(), 1)
+	f := files[0].(*tsspFile)
+	defer f.Close()
+
+	var fds []*syncCountFile
+	create := createTombstoneFile
+	defer func() {
+		createTombstoneFile = create
+	}()
+	createTombstoneFile = func(name string, lock *string) (fileops.File, error) {
+		fd, err := create(name, lock)
+		if err != nil {
+			return nil, err
+		}
+		sfd := &syncCountFile{File: fd}
+		fds = append(fds, sfd)
+		return sfd, nil
+	}
+
+	deleteAndCountSyncs := func() int {
+		fds = fds[:0]
+		for i := 0; i < 3; i++ {
+			require.NoError(t, f.writeTombstones([]int64{1}, int64(i), int64(i)))
+		}
+		syncs := 0
+		for _, fd := range fds {
+			syncs += fd.syncs
+		}
+		return syncs
+	}
+
+	// each call writes and closes a tombstone file
+	require.Equal(t, 3, deleteAndCountSyncs())
+
+	SetTombstoneSyncPolicy(SyncOnClose)
+	require.Equal(t, 3, deleteAndCountSyncs())
+
+	SetTombstoneSyncPolicy(SyncNever)
+	require.Equal(t, 0, deleteAndCountSyncs())
+	require.Equal(t, 9, len(f.TombstoneFiles()))
+}
+
+func TestTSSPFile_WriteTombstones(t *testing.T) {
+	lock := ""
+	files := writeBlockCacheTestFiles(t, t.TempDir(), 1)
+	f := files[0].(*tsspFile)
+	path := f.Path()
+	require.False(t, f.HasTombstones())
+	require.Empty(t, f.TombstoneFiles())
+
+	require.Equal(t, errDeleteNotImplemented, f.Delete([]int64{1, 2}))
+	require.Equal(t, errDeleteNotImplemented, f.DeleteRange([]int64{1, 2}, 10, 20))
+	require.False(t, f.HasTombstones())
+
+	require.NoError(t, f.writeTombstones([]int64{1, 2}, math.MinInt64, math.MaxInt64))
+	require.NoError(t, f.writeTombstones([]int64{3}, 10, 20))
+	require.NoError(t, f.writeTombstones(nil, 10, 20))
+	require.Error(t, f.writeTombstones([]int64{3}, 20, 10))
+	require.True(t, f.HasTombstones())
+
+	exp := [][]Tombstone{
+		{{ID: 1, MinTime: math.MinInt64, MaxTime: math.MaxInt64}, {ID: 2, MinTime: math.MinInt64, MaxTime: math.MaxInt64}},
+		{{ID: 3, MinTime: 10, MaxTime: 20}},
+	}
+	check := func(tf TSSPFile) {
+		ts := tf.TombstoneFiles()
+		require.Equal(t, len(exp), len(ts))
+		for i := range ts {
+			require.Equal(t, tombstoneFilePath(path, i), ts[i].Path())
+			require.Equal(t, exp[i], ts[i].Tombstones())
+		}
+	}
+	check(f)
+
+	// the returned files are copies
+	f.TombstoneFiles()[0].tombstones[0].ID = 100
+	check(f)
+
+	// tombstones are loaded when the file is opened again, except in read-only mode
+	require.NoError(t, f.Close())
+	f2, err := OpenTSSPFile(path, &lock, true, false)
+	require.NoError(t, err)
+	check(f2)
+	ro, err := OpenTSSPFileReadOnly(path, &lock, true)
+	require.NoError(t, err)
+	require.False(t, ro.HasTombstones())
+	require.NoError(t, ro.Close())
+
+	// the tombstones stay with the file while it has the temporary suffix
+	require.NoError(t, f2.Rename(path+tmpTsspFileSuffix))
+	check(f2)
+	require.NoError(t, f2.Rename(path))
+	check(f2)
+
+	require.NoError(t, f2.Remove())
+	for i := range exp {
+		_, err = os.Stat(tombstoneFilePath(path, i))
+		require.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestTombstoneFile_Corrupt(t *testing.T) {
+	lock := ""
+	dir := t.TempDir()
+	path := filepath.Join(dir, "00000001-0000-00000000.tssp")
+	require.NoError(t, os.WriteFile(tombstoneFilePath(path, 0), marshalTombstones([]Tombstone{{ID: 1}}), 0600))
+	require.NoError(t, os.WriteFile(tombstoneFilePath(path, 1), []byte("TSTOMB01xx"), 0600))
+
+	_, err := loadTombstoneFiles(path, &lock)
+	require.Error(t, err)
+
+	require.NoError(t, os.Remove(tombstoneFilePath(path, 1)))
+	ts, err := loadTombstoneFiles(path, &lock)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ts))
+	require.Equal(t, []Tombstone{{ID: 1}}, ts[0].Tombstones())
+}
+
+func TestTombstoneDataFile(t *testing.T) {
+	for name, exp := range map[string]string{
+		"a/00000001-0000-00000000.tssp.0.tomb":     "a/00000001-0000-00000000.tssp",
+		"a/00000001-0000-00000000.tssp.12.tomb":    "a/00000001-0000-00000000.tssp",
+		"a/00000001-0000-00000000.tssp.0.tomb.tmp": "a/00000001-0000-00000000.tssp",
+		"a/00000001-0000-00000000.tssp":            "",
+		"a/00000001-0000-00000000.tssp.init":       "",
+		"a/00000001-0000-00000000.tmp":             "",
+	} {
+		got, ok := tombstoneDataFile(name)
+		require.Equal(t, exp != "", ok, name)
+		require.Equal(t, exp, got, name)
+	}
+	require.Equal(t, "a.tssp.1.tomb", tombstoneFilePath("a.tssp"+tmpTsspFileSuffix, 1))
+}
+
+func TestTSSPFile_CompactTombstones(t *testing.T) {
+	lock := ""
+	files := writeBlockCacheTestFiles(t, t.TempDir(), 1)
+	f := files[0].(*tsspFile)
+	defer f.Close()
+	path := f.Path()
+	require.NoError(t, f.CompactTombstones())
+
+	for i := int64(0); i < 50; i++ {
+		require.NoError(t, f.writeTombstones([]int64{1, 2}, i*10, i*10+15))
+	}
+	require.NoError(t, f.writeTombstones([]int64{3}, 100, 200))
+	require.NoError(t, f.writeTombstones([]int64{3}, 300, 400))
+	require.NoError(t, f.writeTombstones([]int64{3}, 150, 250))
+	exp := []Tombstone{
+		{ID: 1, MinTime: 0, MaxTime: 505},
+		{ID: 2, MinTime: 0, MaxTime: 505},
+		{ID: 3, MinTime: 100, MaxTime: 250},
+		{ID: 3, MinTime: 300, MaxTime: 400},
+	}
+
+	// readers see either the old files or the compacted one, which cover the same ranges
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				var all []Tombstone
+				ts := f.TombstoneFiles()
+				for i := range ts {
+					all = append(all, ts[i].Tombstones()...)
+				}
+				if !assert.Equal(t, exp, mergeTombstones(all)) {
+					return
+				}
+			}
+		}()
+	}
+	require.NoError(t, f.CompactTombstones())
+	close(stop)
+	wg.Wait()
+
+	ts := f.TombstoneFiles()
+	require.Equal(t, 1, len(ts))
+	require.Equal(t, tombstoneFilePath(path, 0), ts[0].Path())
+	require.Equal(t, exp, ts[0].Tombstones())
+
+	items, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Name())
+	}
+	require.ElementsMatch(t, []string{filepath.Base(path), filepath.Base(tombstoneFilePath(path, 0))}, names)
+
+	// the compacted file is loaded by a new handle
+	nf, err := OpenTSSPFile(path, &lock, true, false)
+	require.NoError(t, err)
+	require.Equal(t, ts, nf.TombstoneFiles())
+	require.NoError(t, nf.Close())
+
+	// compacting a single compacted file rewrites the same content
+	require.NoError(t, f.CompactTombstones())
+	require.Equal(t, ts, f.TombstoneFiles())
+
+	ro, err := OpenTSSPFileReadOnly(path, &lock, true)
+	require.NoError(t, err)
+	require.EqualError(t, ro.(*tsspFile).CompactTombstones(), errFileReadOnly.Error())
+	require.NoError(t, ro.Close())
+}
+
+func TestTombstoneView(t *testing.T) {
+	files := writeBlockCacheTestFiles(t, t.TempDir(), 1)
+	f := files[0].(*tsspFile)
+	path := f.Path()
+
+	mi, err := f.MetaIndexAt(0)
+	require.NoError(t, err)
+	cm, err := f.ChunkMeta(mi.id, mi.offset, mi.size, mi.count, 0, nil, nil)
+	require.NoError(t, err)
+	schema, err := f.BlockHeader(cm, nil)
+	require.NoError(t, err)
+
+	readAll := func(tf TSSPFile) []int64 {
+		ctx := AcquireReadContext()
+		defer ReleaseReadContext(ctx)
+		var times []int64
+		for i := 0; i < cm.segmentCount(); i++ {
+			rec := record.NewRecordBuilder(schema)
+			rec, err := tf.ReadAt(cm, i, rec, ctx)
+			require.NoError(t, err)
+			times = append(times, rec.Times()...)
+		}
+		return times
+	}
+	all := readAll(f)
+	require.True(t, len(all) > 10)
+
+	// the view drops the rows deleted by its tombstones, the file is left as it is
+	require.NoError(t, f.writeTombstones([]int64{int64(cm.sid)}, all[2], all[5]))
+	view, err := f.CloneWithTombstones(f.TombstoneFiles())
+	require.NoError(t, err)
+	require.True(t, view.HasTombstones())
+	require.Equal(t, path, view.Path())
+	require.Equal(t, append(append([]int64(nil), all[:2]...), all[6:]...), readAll(view))
+	require.Equal(t, all, readAll(f))
+
+	// the shared file can't be modified through the view
+	require.Equal(t, errFileReadOnly, view.Remove())
+	require.Equal(t, errFileReadOnly, view.Rename(path+tmpTsspFileSuffix))
+	require.Equal(t, errFileReadOnly, view.Delete([]int64{1}))
+	require.Equal(t, errFileReadOnly, view.DeleteRange([]int64{1}, 0, 1))
+	require.Equal(t, errFileReadOnly, view.LoadIntoMemory())
+	require.Equal(t, int64(0), view.Free(true))
+	require.NoError(t, view.FreeFileHandle())
+	view.Stop()
+	require.Equal(t, path, f.Path())
+	require.Equal(t, all, readAll(f))
+
+	// a stopped view is rejected, the others keep reading after the file is closed
+	_, err = view.ReadAt(cm, 0, record.NewRecordBuilder(schema), nil)
+	require.Equal(t, errFileClosed, err)
+	require.NoError(t, view.Close())
+
+	view, err = f.CloneWithTombstones(nil)
+	require.NoError(t, err)
+	view.Ref()
+	done := make(chan error, 1)
+	go func() { done <- f.Close() }()
+	select {
+	case err = <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close is blocked by the view")
+	}
+	require.Equal(t, all, readAll(view))
+
+	require.NoError(t, view.Close())
+	require.Equal(t, all, readAll(view))
+	view.Unref()
+	_, err = view.ReadAt(cm, 0, record.NewRecordBuilder(schema), nil)
+	require.Equal(t, errFileClosed, err)
+}
+
+func TestTSSPFiles_AllTombstoneFiles_RealFiles(t *testing.T) {
+	fs := writeBlockCacheTestFiles(t, t.TempDir(), 3)
+	defer func() {
+		for _, f := range fs {
+			require.NoError(t, f.Close())
+		}
+	}()
+
+	files := NewTSSPFiles()
+	for _, f := range fs {
+		files.Append(f)
+	}
+	require.Empty(t, files.AllTombstoneFiles())
+
+	require.NoError(t, fs[0].(*tsspFile).writeTombstones([]int64{1}, math.MinInt64, math.MaxInt64))
+	require.NoError(t, fs[2].(*tsspFile).writeTombstones([]int64{2}, 10, 20))
+	require.NoError(t, fs[2].(*tsspFile).writeTombstones([]int64{3}, 30, 40))
+
+	all := files.AllTombstoneFiles()
+	require.Equal(t, 2, len(all))
+	require.NotContains(t, all, fs[1].Path())
+	ts := all[fs[0].Path()]
+	require.Equal(t, 1, len(ts))
+	require.Equal(t, tombstoneFilePath(fs[0].Path(), 0), ts[0].Path())
+	require.Equal(t, []Tombstone{{ID: 1, MinTime: math.MinInt64, MaxTime: math.MaxInt64}}, ts[0].Tombstones())
+	ts = all[fs[2].Path()]
+	require.Equal(t, 2, len(ts))
+	for i := range ts {
+		_, err := os.Stat(ts[i].Path())
+		require.NoError(t, err)
+	}
+	require.Equal(t, []Tombstone{{ID: 3, MinTime: 30, MaxTime: 40}}, ts[1].Tombstones())
+
+	// closed files are skipped
+	fs[0].Stop()
+	all = files.AllTombstoneFiles()
+	require.Equal(t, 1, len(all))
+	require.Contains(t, all, fs[2].Path())
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"go.uber.org/zap"
+)
+
+// tombstoneView is a read-only handle of a tssp file with its own set of tombstone files.
+// It reads through the reader of the file, rows deleted by its tombstones are dropped,
+// and it holds no reference to the file itself, so closing the file does not wait for the view.
+// The shared reader is closed by the last of the file and its views
+type tombstoneView struct {
+	TSSPFileReader
+
+	mu     sync.RWMutex
+	f      *tsspFile
+	ref    int32
+	flag   uint32
+	closed int32
+
+	tombstones []TombstoneFile
+	deleted    map[uint64][]Tombstone
+}
+
+// CloneWithTombstones returns a read-only handle sharing the reader of the file, whose tombstones are ts.
+// If the file is closed first, its reader stays open until the last handle is closed
+func (f *tsspFile) CloneWithTombstones(ts []TombstoneFile) (TSSPFile, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.stopped() {
+		return nil, errFileClosed
+	}
+	f.clones++
+	f.reader.Ref()
+
+	v := &tombstoneView{
+		TSSPFileReader: f.reader,
+		f:              f,
+		ref:            1,
+		deleted:        make(map[uint64][]Tombstone),
+	}
+	for i := range ts {
+		v.tombstones = append(v.tombstones, ts[i].clone())
+		for _, t := range ts[i].Tombstones() {
+			v.deleted[t.ID] = append(v.deleted[t.ID], t)
+		}
+	}
+	return v, nil
+}
+
+// closeReader closes the reader, or leaves it to the last clone if any clone is still open
+func (f *tsspFile) closeReader() {
+	f.mu.Lock()
+	closable := f.readerClosable()
+	f.mu.Unlock()
+	if closable {
+		_ = f.reader.Close()
+	}
+}
+
+// readerClosable reports whether the reader can be closed now, otherwise the last clone closes it.
+// The caller must hold the lock of the file
+func (f *tsspFile) readerClosable() bool {
+	if f.clones > 0 {
+		f.readerClosePending = true
+		return false
+	}
+	return true
+}
+
+// releaseClone is called by a view when its last reference is released
+func (f *tsspFile) releaseClone() {
+	f.reader.Unref()
+
+	f.mu.Lock()
+	f.clones--
+	pending := f.clones == 0 && f.readerClosePending
+	f.mu.Unlock()
+	if pending {
+		_ = f.reader.Close()
+	}
+}
+
+func (v *tombstoneView) stopped() bool {
+	return atomic.LoadUint32(&v.flag) > 0
+}
+
+// Stop stops the view only, the shared file is left untouched
+func (v *tombstoneView) Stop() {
+	atomic.AddUint32(&v.flag, 1)
+}
+
+func (v *tombstoneView) Inuse() bool {
+	return atomic.LoadInt32(&v.ref) > 1
+}
+
+func (v *tombstoneView) Ref() {
+	atomic.AddInt32(&v.ref, 1)
+}
+
+func (v *tombstoneView) Unref() {
+	n := atomic.AddInt32(&v.ref, -1)
+	if n < 0 {
+		panic(errRefUnderflow.Error())
+	}
+	if n > 0 {
+		return
+	}
+
+	v.mu.Lock()
+	v.Stop()
+	v.mu.Unlock()
+	v.f.releaseClone()
+}
+
+// Close releases the reference taken by CloneWithTombstones, the reader is released with the last reference
+func (v *tombstoneView) Close() error {
+	if atomic.CompareAndSwapInt32(&v.closed, 0, 1) {
+		v.Unref()
+	}
+	return nil
+}
+
+func (v *tombstoneView) RefFileReader() {
+	v.TSSPFileReader.Ref()
+}
+
+func (v *tombstoneView) UnrefFileReader() {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if v.stopped() {
+		return
+	}
+	v.TSSPFileReader.Unref()
+}
+
+func (v *tombstoneView) FileName() TSSPFileName {
+	return v.f.FileName()
+}
+
+func (v *tombstoneView) LevelAndSequence() (uint16, uint64) {
+	return v.f.LevelAndSequence()
+}
+
+func (v *tombstoneView) FileNameMerge() uint16 {
+	return v.f.FileNameMerge()
+}
+
+func (v *tombstoneView) FileNameExtend() uint16 {
+	return v.f.FileNameExtend()
+}
+
+func (v *tombstoneView) IsOrder() bool {
+	return v.f.IsOrder()
+}
+
+func (v *tombstoneView) Path() string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if v.stopped() {
+		return ""
+	}
+	return v.TSSPFileReader.Path()
+}
+
+func (v *tombstoneView) Name() string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if v.stopped() {
+		return ""
+	}
+	return v.TSSPFileReader.Name()
+}
+
+func (v *tombstoneView) HasTombstones() bool {
+	return len(v.tombstones) > 0
+}
+
+// TombstoneFiles returns a copy of the tombstone files of the view
+func (v *tombstoneView) TombstoneFiles() []TombstoneFile {
+	if len(v.tombstones) == 0 {
+		return nil
+	}
+	ts := make([]TombstoneFile, 0, len(v.tombstones))
+	for i := range v.tombstones {
+		ts = append(ts, v.tombstones[i].clone())
+	}
+	return ts
+}
+
+func (v *tombstoneView) MetaIndexItemNum() int64 {
+	return v.TSSPFileReader.FileStat().MetaIndexItemNum()
+}
+
+func (v *tombstoneView) LoadIdTimes(p *IdTimePairs) error {
+	fr, ok := v.TSSPFileReader.(*tsspFileReader)
+	if !ok {
+		err := fmt.Errorf("LoadIdTimes: disk file isn't *TSSPFileReader type")
+		log.Error("disk file isn't *TSSPFileReader", zap.String("file", v.TSSPFileReader.Path()))
+		return err
+	}
+	return fr.loadIdTimes(v.IsOrder(), p)
+}
+
+func (v *tombstoneView) Read(uint64, record.TimeRange, *record.Record) (*record.Record, error) {
+	panic("impl me")
+}
+
+// ReadAt reads the segment like the file does, rows deleted by the tombstones of the view are dropped
+func (v *tombstoneView) ReadAt(cm *ChunkMeta, segment int, dst *record.Record, decs *ReadContext) (*record.Record, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if v.stopped() {
+		return nil, errFileClosed
+	}
+
+	if segment < 0 || segment >= cm.segmentCount() {
+		err := fmt.Errorf("segment index %d out of range %d", segment, cm.segmentCount())
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	rec, err := v.TSSPFileReader.ReadAt(cm, segment, dst, decs)
+	if err != nil || rec == nil {
+		return rec, err
+	}
+	return filterDeletedRows(rec, v.deleted[cm.sid]), nil
+}
+
+// filterDeletedRows returns the rows of rec whose time is out of all ts, rec itself is returned if none is deleted
+func filterDeletedRows(rec *record.Record, ts []Tombstone) *record.Record {
+	if len(ts) == 0 {
+		return rec
+	}
+
+	deleted := func(tm int64) bool {
+		for i := range ts {
+			if tm >= ts[i].MinTime && tm <= ts[i].MaxTime {
+				return true
+			}
+		}
+		return false
+	}
+
+	var out *record.Record
+	times := rec.Times()
+	start := 0
+	for i, tm := range times {
+		if !deleted(tm) {
+			continue
+		}
+		if out == nil {
+			out = &record.Record{}
+			out.ResetWithSchema(rec.Schema)
+		}
+		if start < i {
+			out.AppendRec(rec, start, i)
+		}
+		start = i + 1
+	}
+	if out == nil {
+		return rec
+	}
+	if start < len(times) {
+		out.AppendRec(rec, start, len(times))
+	}
+	return out
+}
+
+// The methods below modify the shared file, they are rejected or ignored by the view
+
+func (v *tombstoneView) Open() error {
+	return nil
+}
+
+func (v *tombstoneView) Reopen() error {
+	return errFileReadOnly
+}
+
+func (v *tombstoneView) Rename(string) error {
+	return errFileReadOnly
+}
+
+func (v *tombstoneView) Remove() error {
+	return errFileReadOnly
+}
+
+func (v *tombstoneView) Delete([]int64) error {
+	return errFileReadOnly
+}
+
+func (v *tombstoneView) DeleteRange([]int64, int64, int64) error {
+	return errFileReadOnly
+}
+
+func (v *tombstoneView) LoadIntoMemory() error {
+	return errFileReadOnly
+}
+
+func (v *tombstoneView) FreeMemory() int64 {
+	return 0
+}
+
+func (v *tombstoneView) FreeFileHandle() error {
+	return nil
+}
+
+func (v *tombstoneView) Free(bool) int64 {
+	return 0
+}
+
+func (v *tombstoneView) AddToEvictList(uint16) {}
+
+func (v *tombstoneView) RemoveFromEvictList(uint16) {}
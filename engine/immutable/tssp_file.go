@@ -18,14 +18,20 @@ package immutable
 
 import (
 	"container/list"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/influxdata/influxdb/pkg/limiter"
+	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
 	"go.uber.org/zap"
 )
 
@@ -44,6 +50,9 @@ const (
 )
 
 var errFileClosed = fmt.Errorf("tssp file closed")
+var errRefUnderflow = fmt.Errorf("file closed")
+var errFileReadOnly = fmt.Errorf("tssp file is read-only")
+var errDeleteNotImplemented = fmt.Errorf("delete of tssp file is not implemented")
 
 type TSSPFile interface {
 	FileName() TSSPFileName
@@ -53,6 +62,7 @@ type TSSPFile interface {
 	IsOrder() bool
 	RefFileReader()
 	UnrefFileReader()
+	Reopen() error
 	Stop()
 	Inuse() bool
 	Read(id uint64, tr record.TimeRange, dst *record.Record) (*record.Record, error)
@@ -128,6 +138,105 @@ func (f *TSSPFiles) StopFiles() {
 	f.lock.RUnlock()
 }
 
+// StopFilesAndWait stops all files and waits up to timeout for in-flight readers to drain
+func (f *TSSPFiles) StopFilesAndWait(timeout time.Duration) error {
+	f.StopFiles()
+
+	f.lock.RLock()
+	files := make([]TSSPFile, len(f.files))
+	copy(files, f.files)
+	f.lock.RUnlock()
+
+	// WaitIdle returns once ctx is done, no waiter is left behind on timeout
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	drained := true
+	for _, tf := range files {
+		if sf, ok := tf.(*tsspFile); ok {
+			if err := sf.WaitIdle(ctx); err != nil {
+				drained = false
+				break
+			}
+		}
+	}
+	if drained {
+		return nil
+	}
+
+	var inuse []string
+	for _, tf := range files {
+		if tf.Inuse() {
+			name := tf.FileName()
+			inuse = append(inuse, name.String())
+		}
+	}
+	if len(inuse) == 0 {
+		return nil
+	}
+	return fmt.Errorf("wait for files to drain timeout after %v, files still in use: %v", timeout, inuse)
+}
+
+// LoadIntoMemory loads the files into memory concurrently with the given number of workers.
+// The file size is taken as the memory to be used, loading stops once the total exceeds maxBytes
+func (f *TSSPFiles) LoadIntoMemory(maxBytes int64, workers int) error {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	f.lock.RLock()
+	files := make([]TSSPFile, len(f.files))
+	copy(files, f.files)
+	f.lock.RUnlock()
+
+	var used int64
+	var full int32
+	var errOnce sync.Once
+	var loadErr error
+	var wg sync.WaitGroup
+
+	ch := make(chan TSSPFile, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tf := range ch {
+				if atomic.LoadInt32(&full) > 0 {
+					continue
+				}
+
+				size := tf.FileSize()
+				if atomic.AddInt64(&used, size) > maxBytes {
+					atomic.AddInt64(&used, -size)
+					atomic.StoreInt32(&full, 1)
+					continue
+				}
+
+				if err := tf.LoadIntoMemory(); err != nil {
+					atomic.AddInt64(&used, -size)
+					errOnce.Do(func() {
+						loadErr = err
+					})
+				}
+			}
+		}()
+	}
+
+	for _, tf := range files {
+		if atomic.LoadInt32(&full) > 0 || atomic.LoadInt64(&f.closing) > 0 {
+			break
+		}
+		if tf.InMemSize() > 0 {
+			continue
+		}
+		ch <- tf
+	}
+	close(ch)
+	wg.Wait()
+
+	return loadErr
+}
+
 func (f *TSSPFiles) fileIndex(tbl TSSPFile) int {
 	if len(f.files) == 0 {
 		return -1
@@ -180,6 +289,135 @@ func (f *TSSPFiles) Files() []TSSPFile {
 	return f.files
 }
 
+// TotalSize returns the sum of the sizes of the files on disk, closed files are skipped
+func (f *TSSPFiles) TotalSize() int64 {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	var size int64
+	for _, tf := range f.files {
+		if fileStopped(tf) {
+			continue
+		}
+		size += tf.FileSize()
+	}
+	return size
+}
+
+// SizeByLevel returns the sum of the sizes of the files on disk grouped by level,
+// closed files are skipped
+func (f *TSSPFiles) SizeByLevel() map[uint16]int64 {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	sizes := make(map[uint16]int64)
+	for _, tf := range f.files {
+		if fileStopped(tf) {
+			continue
+		}
+		level, _ := tf.LevelAndSequence()
+		sizes[level] += tf.FileSize()
+	}
+	return sizes
+}
+
+// FilesByLevel returns the files of the level, each returned file is referenced and must be unreferenced by the caller.
+// The files are sorted by sequence rather than level, so it is a linear scan. Closed files are skipped
+func (f *TSSPFiles) FilesByLevel(level uint16) []TSSPFile {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	var files []TSSPFile
+	for _, tf := range f.files {
+		if fileStopped(tf) {
+			continue
+		}
+		if lv, _ := tf.LevelAndSequence(); lv != level {
+			continue
+		}
+		tf.Ref()
+		tf.RefFileReader()
+		files = append(files, tf)
+	}
+	return files
+}
+
+// Clone returns a copy of the files that is not changed by later Append or deleteFile,
+// each returned file is referenced and must be released by ReleaseClone. Closed files are skipped
+func (f *TSSPFiles) Clone() []TSSPFile {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	files := make([]TSSPFile, 0, len(f.files))
+	for _, tf := range f.files {
+		if fileStopped(tf) {
+			continue
+		}
+		tf.Ref()
+		tf.RefFileReader()
+		files = append(files, tf)
+	}
+	return files
+}
+
+// ReleaseClone unreferences the files returned by TSSPFiles.Clone
+func ReleaseClone(files []TSSPFile) {
+	for _, tf := range files {
+		tf.UnrefFileReader()
+		tf.Unref()
+	}
+}
+
+// AllTombstoneFiles returns the tombstone files of each file keyed by the file path,
+// files without tombstones and closed files are skipped
+func (f *TSSPFiles) AllTombstoneFiles() map[string][]TombstoneFile {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	tombstones := make(map[string][]TombstoneFile)
+	for _, tf := range f.files {
+		if fileStopped(tf) {
+			continue
+		}
+		if ts := tf.TombstoneFiles(); len(ts) > 0 {
+			tombstones[tf.Path()] = ts
+		}
+	}
+	return tombstones
+}
+
+// MaxLevel returns the highest level of the files, closed files are skipped
+func (f *TSSPFiles) MaxLevel() uint16 {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	var maxLevel uint16
+	for _, tf := range f.files {
+		if fileStopped(tf) {
+			continue
+		}
+		if lv, _ := tf.LevelAndSequence(); lv > maxLevel {
+			maxLevel = lv
+		}
+	}
+	return maxLevel
+}
+
+// TotalInMemSize returns the sum of the memory used by the files loaded into memory
+func (f *TSSPFiles) TotalInMemSize() int64 {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	var size int64
+	for _, tf := range f.files {
+		if fileStopped(tf) {
+			continue
+		}
+		size += tf.InMemSize()
+	}
+	return size
+}
+
 func (f *TSSPFiles) deleteFile(tbl TSSPFile) {
 	idx := f.fileIndex(tbl)
 	if idx < 0 || idx >= f.Len() {
@@ -202,20 +440,49 @@ type tsspFile struct {
 	flag uint32 // flag > 0 indicates that the files is need close.
 	lock *string
 
-	memEle *list.Element // lru node
-	reader TSSPFileReader
+	memEle   *list.Element // lru node
+	memFreed bool          // the data loaded into memory has been freed, it is reloaded by Open
+	reader   TSSPFileReader
+
+	// time range cached by MinMaxTime
+	timeCached       bool
+	minTime, maxTime int64
+
+	// WaitIdle waiters are woken up by closing idleCh when the last external reference is released
+	idleMu      sync.Mutex
+	idleCh      chan struct{}
+	idleWaiters int32
+
+	// handles created by CloneWithTombstones that share the reader, the reader is closed by the last one
+	// if the file is closed before them
+	clones             int
+	readerClosePending bool
+
+	readOnly bool // opened by OpenTSSPFileReadOnly, tombstone writes are rejected
+
+	// tombstone files written by writeTombstones, numbered by their index
+	tombMu     sync.RWMutex
+	tombstones []TombstoneFile
 }
 
 func OpenTSSPFile(name string, lockPath *string, isOrder bool, cacheData bool) (TSSPFile, error) {
+	return openTSSPFile(name, lockPath, isOrder, cacheData, false)
+}
+
+func openTSSPFile(name string, lockPath *string, isOrder bool, cacheData bool, readOnly bool) (*tsspFile, error) {
 	var fileName TSSPFileName
 	if err := fileName.ParseFileName(name); err != nil {
-		return nil, err
+		return nil, errno.NewError(errno.InvalidTsspFileName, name, err)
 	}
 	fileName.SetOrder(isOrder)
 
 	fr, err := NewTSSPFileReader(name, lockPath)
-	if err != nil || fr == nil {
-		return nil, err
+	if err != nil {
+		if errno.Equal(err, errno.TsspFileNotExist) || errno.Equal(err, errno.TsspTrailerCorrupt) ||
+			errno.Equal(err, errno.TsspFileVersionIncompatible) {
+			return nil, err
+		}
+		return nil, errno.NewError(errno.TsspReaderOpenFailed, name, err).SetCause(err)
 	}
 
 	fr.inMemBlock = emptyMemReader
@@ -225,21 +492,47 @@ func OpenTSSPFile(name string, lockPath *string, isOrder bool, cacheData bool) (
 	}
 
 	if err = fr.Open(); err != nil {
-		return nil, err
+		return nil, errno.NewError(errno.TsspReaderOpenFailed, name, err).SetCause(err)
+	}
+
+	var tombstones []TombstoneFile
+	if !readOnly {
+		tombstones, err = loadTombstoneFiles(name, lockPath)
+		if err != nil {
+			_ = fr.Close()
+			return nil, errno.NewError(errno.TsspReaderOpenFailed, name, err).SetCause(err)
+		}
 	}
 
 	return &tsspFile{
-		name:   fileName,
-		reader: fr,
-		ref:    1,
-		lock:   lockPath,
+		name:       fileName,
+		reader:     fr,
+		ref:        1,
+		lock:       lockPath,
+		readOnly:   readOnly,
+		tombstones: tombstones,
 	}, nil
 }
 
+// OpenTSSPFileReadOnly opens the file for reads only, such as serving a historical backup:
+// no tombstone file is loaded for it and tombstone writes return an error
+func OpenTSSPFileReadOnly(name string, lockPath *string, isOrder bool) (TSSPFile, error) {
+	f, err := openTSSPFile(name, lockPath, isOrder, false, true)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
 func (f *tsspFile) stopped() bool {
 	return atomic.LoadUint32(&f.flag) > 0
 }
 
+func fileStopped(f TSSPFile) bool {
+	sf, ok := f.(interface{ stopped() bool })
+	return ok && sf.stopped()
+}
+
 func (f *tsspFile) Stop() {
 	atomic.AddUint32(&f.flag, 1)
 }
@@ -249,22 +542,76 @@ func (f *tsspFile) Inuse() bool {
 }
 
 func (f *tsspFile) Ref() {
+	f.TryRef()
+}
+
+// TryRef increases the reference count of the file, returns false if the file is stopped
+func (f *tsspFile) TryRef() bool {
 	if f.stopped() {
-		return
+		return false
 	}
 
 	atomic.AddInt32(&f.ref, 1)
 	f.wg.Add(1)
+	return true
 }
 
 func (f *tsspFile) Unref() {
-	if atomic.AddInt32(&f.ref, -1) <= 0 {
+	if err := f.UnrefErr(); err != nil {
+		panic(err.Error())
+	}
+}
+
+// UnrefErr decreases the reference count of the file,
+// returns an error instead of panicking if the reference count underflows
+func (f *tsspFile) UnrefErr() error {
+	n := atomic.AddInt32(&f.ref, -1)
+	if n <= 0 {
 		if f.stopped() {
-			return
+			return nil
 		}
-		panic("file closed")
+		return errRefUnderflow
 	}
 	f.wg.Done()
+	if n == 1 && atomic.LoadInt32(&f.idleWaiters) > 0 {
+		f.notifyIdle()
+	}
+	return nil
+}
+
+func (f *tsspFile) notifyIdle() {
+	f.idleMu.Lock()
+	if f.idleCh != nil {
+		close(f.idleCh)
+		f.idleCh = nil
+	}
+	f.idleMu.Unlock()
+}
+
+// WaitIdle blocks until the file has no external references or ctx is done.
+// New references may be taken as soon as it returns, so callers must coordinate with the readers if that matters
+func (f *tsspFile) WaitIdle(ctx context.Context) error {
+	atomic.AddInt32(&f.idleWaiters, 1)
+	defer atomic.AddInt32(&f.idleWaiters, -1)
+
+	for {
+		f.idleMu.Lock()
+		if !f.Inuse() {
+			f.idleMu.Unlock()
+			return nil
+		}
+		if f.idleCh == nil {
+			f.idleCh = make(chan struct{})
+		}
+		ch := f.idleCh
+		f.idleMu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 }
 
 func (f *tsspFile) RefFileReader() {
@@ -351,7 +698,16 @@ func (f *tsspFile) FreeMemory() int64 {
 	}
 
 	size := f.reader.FreeMemory()
+	if size > 0 {
+		f.memFreed = true
+	}
 	f.mu.Unlock()
+
+	if size > 0 {
+		if fn := onEvict.Load().(EvictHook); fn != nil {
+			fn(f, size)
+		}
+	}
 	return size
 }
 
@@ -387,6 +743,17 @@ func (f *tsspFile) FreeFileHandle() error {
 	return nil
 }
 
+// Reopen reacquires the file handle released by FreeFileHandle.
+// Reads trigger it implicitly, so callers only need it to reopen ahead of time
+func (f *tsspFile) Reopen() error {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.stopped() {
+		return errFileClosed
+	}
+	return f.reader.LoadComponents()
+}
+
 func (f *tsspFile) MetaIndex(id uint64, tr record.TimeRange) (int, *MetaIndex, error) {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
@@ -460,6 +827,207 @@ func (f *tsspFile) ReadAt(cm *ChunkMeta, segment int, dst *record.Record, decs *
 	return f.reader.ReadAt(cm, segment, dst, decs)
 }
 
+// ReadRange returns an iterator reading the segments of cm in tr one by one,
+// segments out of tr are skipped according to their time ranges without being read
+func (f *tsspFile) ReadRange(cm *ChunkMeta, tr record.TimeRange, decs *ReadContext) (SegmentIterator, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.stopped() {
+		return nil, errFileClosed
+	}
+
+	return newSegmentRangeIterator(f, cm, tr, decs), nil
+}
+
+// EstimateRows estimates the number of rows of the series id in tr from the metadata, no column data is decoded.
+// Rows are assumed to be evenly distributed over the segments of the chunk,
+// and all rows of a segment partially overlapping with tr are counted
+func (f *tsspFile) EstimateRows(id uint64, tr record.TimeRange) (int64, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.stopped() {
+		return 0, errFileClosed
+	}
+
+	cm, err := f.chunkMetaOf(id, tr)
+	if err != nil || cm == nil {
+		return 0, err
+	}
+
+	tb := acquireTimePreAggBuilder()
+	rows := int64(cm.Rows(tb))
+	tb.release()
+	if cm.allRowsInRange(tr) {
+		return rows, nil
+	}
+
+	segs := int64(cm.segmentCount())
+	overlapped := int64(0)
+	for i := range cm.timeRange[:segs] {
+		if tr.Overlaps(cm.timeRange[i].minTime(), cm.timeRange[i].maxTime()) {
+			overlapped++
+		}
+	}
+	return (rows*overlapped + segs - 1) / segs, nil
+}
+
+// ReadFields reads the rows of the series id in tr into dst, only the columns of fields and the time column are decoded.
+// dst is reset to the sorted fields followed by the time column. Fields missing in the file,
+// such as fields added after the file is written, come back as null columns of the requested type
+func (f *tsspFile) ReadFields(id uint64, tr record.TimeRange, fields record.Schemas, dst *record.Record) error {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.stopped() {
+		return errFileClosed
+	}
+
+	schema := fieldsSchema(fields)
+	dst.ResetWithSchema(schema)
+	cm, err := f.chunkMetaOf(id, tr)
+	if err != nil || cm == nil {
+		return err
+	}
+
+	header, err := f.reader.BlockHeader(cm, nil)
+	if err != nil {
+		return err
+	}
+	columns := make(record.Schemas, 0, len(schema))
+	for _, field := range schema[:len(schema)-1] {
+		idx := cm.columnIndexByName(field.Name)
+		if idx < 0 {
+			continue
+		}
+		if header[idx].Type != field.Type {
+			return fmt.Errorf("field %s is of type %d in file %s, %d is requested",
+				field.Name, header[idx].Type, f.reader.Path(), field.Type)
+		}
+		columns = append(columns, header[idx])
+	}
+	if len(columns) == 0 {
+		// the reader decodes nothing without a field column, the time column is read as a field like count(time) does
+		columns = append(columns, header[len(header)-1])
+	}
+	columns = append(columns, header[len(header)-1])
+
+	ctx := AcquireReadContext()
+	defer ReleaseReadContext(ctx)
+	rec := &record.Record{}
+	for i := 0; i < cm.segmentCount(); i++ {
+		sr := &cm.timeRange[i]
+		if !tr.Overlaps(sr.minTime(), sr.maxTime()) {
+			continue
+		}
+
+		rec.ResetWithSchema(columns)
+		rec, err = f.reader.ReadAt(cm, i, rec, ctx)
+		if err != nil {
+			return err
+		}
+
+		times := rec.Times()
+		start := sort.Search(len(times), func(j int) bool { return times[j] >= tr.Min })
+		end := sort.Search(len(times), func(j int) bool { return times[j] > tr.Max })
+		if start < end {
+			appendRowsPadded(dst, rec, start, end)
+		}
+	}
+	return nil
+}
+
+// fieldsSchema returns the sorted fields without duplicates followed by the time column
+func fieldsSchema(fields record.Schemas) record.Schemas {
+	schema := make(record.Schemas, 0, len(fields)+1)
+	for _, field := range fields {
+		if field.Name == record.TimeField || schema.FieldIndex(field.Name) >= 0 {
+			continue
+		}
+		schema = append(schema, field)
+	}
+	sort.Sort(schema)
+	return append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+}
+
+// appendRowsPadded appends the rows [start, end) of src to dst, the schema of src is a subset of the schema of dst,
+// both sorted by name with the time column last. The columns of dst missing in src are padded with nulls
+func appendRowsPadded(dst, src *record.Record, start, end int) {
+	k := 0
+	for i := range dst.Schema {
+		if k < len(src.Schema) && src.Schema[k].Name == dst.Schema[i].Name {
+			dst.ColVals[i].AppendColVal(&src.ColVals[k], src.Schema[k].Type, start, end)
+			k++
+			continue
+		}
+		dst.ColVals[i].PadColVal(dst.Schema[i].Type, end-start)
+	}
+}
+
+// ReadAtColumns is like ReadAt, but only the columns of fields and the time column of the segment are decoded,
+// dst is reset to their schema. Fields missing in the chunk are skipped, nil is returned if none of them is in the chunk
+func (f *tsspFile) ReadAtColumns(cm *ChunkMeta, segment int, fields []string, dst *record.Record, decs *ReadContext) (*record.Record, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.stopped() {
+		return nil, errFileClosed
+	}
+
+	if segment < 0 || segment >= cm.segmentCount() {
+		err := fmt.Errorf("segment index %d out of range %d", segment, cm.segmentCount())
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	schema, err := f.columnsSchema(cm, fields)
+	if err != nil || schema == nil {
+		return nil, err
+	}
+	dst.ResetWithSchema(schema)
+	return f.reader.ReadAt(cm, segment, dst, decs)
+}
+
+// columnsSchema returns the sorted schema of the columns of fields in the chunk followed by the time column,
+// or nil if none of fields is in the chunk
+func (f *tsspFile) columnsSchema(cm *ChunkMeta, fields []string) (record.Schemas, error) {
+	header, err := f.reader.BlockHeader(cm, nil)
+	if err != nil {
+		return nil, err
+	}
+	schema := make(record.Schemas, 0, len(fields)+1)
+	for _, name := range fields {
+		idx := cm.columnIndexByName(name)
+		if idx < 0 || name == record.TimeField || schema.FieldIndex(name) >= 0 {
+			continue
+		}
+		schema = append(schema, header[idx])
+	}
+	if len(schema) == 0 {
+		return nil, nil
+	}
+	sort.Sort(schema)
+	return append(schema, header[len(header)-1]), nil
+}
+
+// chunkMetaOf returns the chunk meta of the series id if its time range overlaps with tr, or nil.
+// The caller must hold the lock of the file
+func (f *tsspFile) chunkMetaOf(id uint64, tr record.TimeRange) (*ChunkMeta, error) {
+	idx, mi, err := f.reader.MetaIndex(id, tr)
+	if err != nil || mi == nil {
+		return nil, err
+	}
+
+	var buf []byte
+	cm, err := f.reader.ChunkMeta(id, mi.offset, mi.size, mi.count, idx, &ChunkMeta{}, &buf)
+	if err != nil || cm == nil {
+		return nil, err
+	}
+	if !tr.Overlaps(cm.MinMaxTime()) {
+		return nil, nil
+	}
+	return cm, nil
+}
+
 func (f *tsspFile) ChunkMetaAt(index int) (*ChunkMeta, error) {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
@@ -520,28 +1088,150 @@ func (f *tsspFile) ContainsTime(tr record.TimeRange) (contains bool, err error)
 	return f.reader.ContainsTime(tr)
 }
 
+// Delete is not implemented, the reads, compactions and exports of the file do not apply tombstones
 func (f *tsspFile) Delete([]int64) error {
-	f.mu.RLock()
-	defer f.mu.RUnlock()
-	panic("impl me")
+	return errDeleteNotImplemented
 }
 
+// DeleteRange is not implemented, see Delete
 func (f *tsspFile) DeleteRange([]int64, int64, int64) error {
+	return errDeleteNotImplemented
+}
+
+// writeTombstones persists the tombstones of the series ids within [min, max] in a new tombstone file of the file,
+// the file is synced as the tombstone sync policy says
+func (f *tsspFile) writeTombstones(ids []int64, min, max int64) error {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
-	panic("impl me")
+	if f.readOnly {
+		return errFileReadOnly
+	}
+	if f.stopped() {
+		return errFileClosed
+	}
+	if min > max {
+		return fmt.Errorf("invalid delete time range [%d, %d]", min, max)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ts := make([]Tombstone, len(ids))
+	for i, id := range ids {
+		ts[i] = Tombstone{ID: uint64(id), MinTime: min, MaxTime: max}
+	}
+
+	f.tombMu.Lock()
+	defer f.tombMu.Unlock()
+
+	path := tombstoneFilePath(f.reader.Path(), len(f.tombstones))
+	if err := writeTombstoneFile(path, ts, f.lock, false); err != nil {
+		return err
+	}
+	f.tombstones = append(f.tombstones, TombstoneFile{path: path, tombstones: ts})
+	return nil
 }
 
 func (f *tsspFile) HasTombstones() bool {
-	f.mu.RLock()
-	defer f.mu.RUnlock()
-	panic("impl me")
+	f.tombMu.RLock()
+	defer f.tombMu.RUnlock()
+	return len(f.tombstones) > 0
 }
 
+// TombstoneFiles returns a copy of the tombstone files of the file
 func (f *tsspFile) TombstoneFiles() []TombstoneFile {
+	f.tombMu.RLock()
+	defer f.tombMu.RUnlock()
+	if len(f.tombstones) == 0 {
+		return nil
+	}
+
+	files := make([]TombstoneFile, 0, len(f.tombstones))
+	for i := range f.tombstones {
+		files = append(files, f.tombstones[i].clone())
+	}
+	return files
+}
+
+// CompactTombstones merges the tombstone files of the file into one with the overlapping and adjacent
+// ranges of each id coalesced. The merged file is synced and renamed over the first tombstone file,
+// then the others are removed from the last one, so a crash leaves either set of files readable.
+// Readers get the tombstones under tombMu, they see either the old or the merged set
+func (f *tsspFile) CompactTombstones() error {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
-	panic("impl me")
+	if f.readOnly {
+		return errFileReadOnly
+	}
+	if f.stopped() {
+		return errFileClosed
+	}
+
+	f.tombMu.Lock()
+	defer f.tombMu.Unlock()
+
+	if len(f.tombstones) == 0 {
+		return nil
+	}
+	var all []Tombstone
+	for i := range f.tombstones {
+		all = append(all, f.tombstones[i].tombstones...)
+	}
+	merged := mergeTombstones(all)
+
+	path := f.tombstones[0].path
+	if err := writeTombstoneFile(path, merged, f.lock, true); err != nil {
+		return err
+	}
+	superseded := f.tombstones[1:]
+	f.tombstones = []TombstoneFile{{path: path, tombstones: merged}}
+
+	lock := fileops.FileLockOption(*f.lock)
+	for i := len(superseded) - 1; i >= 0; i-- {
+		if err := retryRemove(superseded[i].path, fileops.Remove, lock); err != nil {
+			// the remaining files only repeat tombstones of the merged one
+			f.tombstones = append(f.tombstones, superseded[:i+1]...)
+			return errRemoveFail(superseded[i].path, err)
+		}
+	}
+	return nil
+}
+
+// renameTombstoneFiles moves the tombstone files along with the tssp file renamed to name,
+// they are left in place if only the temporary suffix of the file is added or removed
+func (f *tsspFile) renameTombstoneFiles(name string) error {
+	f.tombMu.Lock()
+	defer f.tombMu.Unlock()
+
+	if len(f.tombstones) == 0 || f.tombstones[0].path == tombstoneFilePath(name, 0) {
+		return nil
+	}
+	lock := fileops.FileLockOption(*f.lock)
+	for i := range f.tombstones {
+		path := tombstoneFilePath(name, i)
+		if err := fileops.RenameFile(f.tombstones[i].path, path, lock); err != nil {
+			return err
+		}
+		f.tombstones[i].path = path
+	}
+	return nil
+}
+
+// removeTombstoneFiles removes the tombstone files from the last one,
+// so that the remaining ones are still numbered without gaps if it fails
+func (f *tsspFile) removeTombstoneFiles() error {
+	f.tombMu.Lock()
+	defer f.tombMu.Unlock()
+
+	lock := fileops.FileLockOption(*f.lock)
+	for i := len(f.tombstones) - 1; i >= 0; i-- {
+		if err := retryRemove(f.tombstones[i].path, fileops.Remove, lock); err != nil {
+			f.tombstones = f.tombstones[:i+1]
+			return err
+		}
+	}
+	f.tombstones = nil
+	return nil
 }
 
 func (f *tsspFile) Rename(newName string) error {
@@ -550,7 +1240,90 @@ func (f *tsspFile) Rename(newName string) error {
 	if f.stopped() {
 		return errFileClosed
 	}
-	return f.reader.Rename(newName)
+	f.timeCached = false
+	if err := f.reader.Rename(newName); err != nil {
+		return err
+	}
+	return f.renameTombstoneFiles(newName)
+}
+
+// CopyTo copies the file and its tombstone files into dir and opens the copy, the original file is left intact.
+// The data is written to temporary files first, so dir never holds a partial copy under the final names
+func (f *tsspFile) CopyTo(dir string, lock *string) (TSSPFile, error) {
+	f.mu.RLock()
+	if f.stopped() {
+		f.mu.RUnlock()
+		return nil, errFileClosed
+	}
+
+	src := f.reader.Path()
+	dst := filepath.Join(dir, filepath.Base(src))
+	for _, target := range []string{dst, tombstoneFilePath(dst, 0)} {
+		if _, err := fileops.Stat(target); err == nil {
+			f.mu.RUnlock()
+			return nil, fmt.Errorf("copy file %s to %s: target file %s exists", src, dst, target)
+		}
+	}
+
+	lockOpt := fileops.FileLockOption(*lock)
+	if err := fileops.MkdirAll(dir, 0750, lockOpt); err != nil {
+		f.mu.RUnlock()
+		return nil, err
+	}
+
+	tmp := dst + tmpTsspFileSuffix
+	_, err := fileops.CopyFile(src, tmp, lockOpt)
+	tombstones := 0
+	if err == nil {
+		tombstones, err = f.copyTombstoneFiles(dst, lockOpt)
+	}
+	f.mu.RUnlock()
+	if err != nil {
+		_ = fileops.Remove(tmp, lockOpt)
+		removeCopiedTombstoneFiles(dst, tombstones, lockOpt)
+		return nil, err
+	}
+
+	if err = fileops.RenameFile(tmp, dst, lockOpt); err != nil {
+		_ = fileops.Remove(tmp, lockOpt)
+		removeCopiedTombstoneFiles(dst, tombstones, lockOpt)
+		return nil, err
+	}
+
+	nf, err := OpenTSSPFile(dst, lock, f.name.order, false)
+	if err != nil {
+		_ = fileops.Remove(dst, lockOpt)
+		removeCopiedTombstoneFiles(dst, tombstones, lockOpt)
+		return nil, err
+	}
+	return nf, nil
+}
+
+// copyTombstoneFiles copies the tombstone files to those of the tssp file dst,
+// and returns the number of files copied
+func (f *tsspFile) copyTombstoneFiles(dst string, lock fileops.FSOption) (int, error) {
+	f.tombMu.RLock()
+	defer f.tombMu.RUnlock()
+
+	for i := range f.tombstones {
+		path := tombstoneFilePath(dst, i)
+		tmp := path + tmpTombstoneFileSuffix
+		_, err := fileops.CopyFile(f.tombstones[i].path, tmp, lock)
+		if err == nil {
+			err = fileops.RenameFile(tmp, path, lock)
+		}
+		if err != nil {
+			_ = fileops.Remove(tmp, lock)
+			return i, err
+		}
+	}
+	return len(f.tombstones), nil
+}
+
+func removeCopiedTombstoneFiles(dst string, n int, lock fileops.FSOption) {
+	for i := n - 1; i >= 0; i-- {
+		_ = fileops.Remove(tombstoneFilePath(dst, i), lock)
+	}
 }
 
 func (f *tsspFile) Remove() error {
@@ -566,10 +1339,15 @@ func (f *tsspFile) Remove() error {
 		order := f.name.order
 
 		log.Debug("remove file", zap.String("file", name))
-		_ = f.reader.Close()
+		if f.readerClosable() {
+			_ = f.reader.Close()
+		}
 		lock := fileops.FileLockOption(*f.lock)
-		err := fileops.Remove(name, lock)
-		if err != nil && !os.IsNotExist(err) {
+		err := retryRemove(name, fileops.Remove, lock)
+		if err == nil {
+			err = f.removeTombstoneFiles()
+		}
+		if err != nil {
 			err = errRemoveFail(name, err)
 			log.Error("remove file fail", zap.Error(err))
 			f.mu.Unlock()
@@ -605,7 +1383,7 @@ func (f *tsspFile) Close() error {
 
 	f.Unref()
 	f.wg.Wait()
-	_ = f.reader.Close()
+	f.closeReader()
 
 	if memSize > 0 && !tmp {
 		if order {
@@ -619,10 +1397,35 @@ func (f *tsspFile) Close() error {
 	return nil
 }
 
+// Open reacquires the resources released under memory pressure:
+// the file handle released by FreeFileHandle is reopened,
+// and the data freed by Free is loaded into memory again
 func (f *tsspFile) Open() error {
 	f.mu.Lock()
-	defer f.mu.Unlock()
+	if f.stopped() {
+		f.mu.Unlock()
+		return errFileClosed
+	}
 
+	if err := f.reader.LoadComponents(); err != nil {
+		f.mu.Unlock()
+		return err
+	}
+
+	reload := f.memFreed
+	f.memFreed = false
+	f.mu.Unlock()
+
+	if !reload {
+		return nil
+	}
+
+	if err := f.LoadIntoMemory(); err != nil {
+		f.mu.Lock()
+		f.memFreed = true
+		f.mu.Unlock()
+		return err
+	}
 	return nil
 }
 
@@ -697,13 +1500,77 @@ func (f *tsspFile) Version() uint64 {
 	return f.reader.Version()
 }
 
+// FileVersionInfo returns the version of the file and whether it can be decoded
+// by this binary, reason describes why if it can not
+func (f *tsspFile) FileVersionInfo() (version uint64, compatible bool, reason string) {
+	version = f.Version()
+	compatible, reason = checkFileVersion(version)
+	return
+}
+
+// MinMaxTime returns the time range of the file, it is read from the reader once and cached,
+// and the cache is invalidated when the file is renamed
 func (f *tsspFile) MinMaxTime() (int64, int64, error) {
 	f.mu.RLock()
-	defer f.mu.RUnlock()
 	if f.stopped() {
+		f.mu.RUnlock()
 		return 0, 0, errFileClosed
 	}
-	return f.reader.MinMaxTime()
+	if f.timeCached {
+		min, max := f.minTime, f.maxTime
+		f.mu.RUnlock()
+		return min, max, nil
+	}
+	f.mu.RUnlock()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.stopped() {
+		return 0, 0, errFileClosed
+	}
+	if !f.timeCached {
+		min, max, err := f.reader.MinMaxTime()
+		if err != nil {
+			return 0, 0, err
+		}
+		f.minTime, f.maxTime, f.timeCached = min, max, true
+	}
+	return f.minTime, f.maxTime, nil
+}
+
+// FileSummary is the file level statistics of a tssp file
+type FileSummary struct {
+	MinTime          int64
+	MaxTime          int64
+	MinSeriesID      uint64
+	MaxSeriesID      uint64
+	MetaIndexItemNum int64
+	FileSize         int64
+	InMemSize        int64
+}
+
+// Summary returns the file level statistics with the lock acquired once,
+// instead of calling MinMaxTime, MinMaxSeriesID, MetaIndexItemNum and so on one by one
+func (f *tsspFile) Summary() (FileSummary, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	var s FileSummary
+	if f.stopped() {
+		return s, errFileClosed
+	}
+
+	var err error
+	if s.MinTime, s.MaxTime, err = f.reader.MinMaxTime(); err != nil {
+		return s, err
+	}
+	if s.MinSeriesID, s.MaxSeriesID, err = f.reader.MinMaxSeriesID(); err != nil {
+		return s, err
+	}
+	s.MetaIndexItemNum = f.reader.FileStat().MetaIndexItemNum()
+	s.FileSize = f.reader.FileSize()
+	s.InMemSize = f.reader.InMemSize()
+	return s, nil
 }
 
 func (f *tsspFile) AddToEvictList(level uint16) {
@@ -762,6 +1629,89 @@ func (f *tsspFile) MinMaxSeriesID() (min, max uint64, err error) {
 	return f.reader.MinMaxSeriesID()
 }
 
+// ColumnStats merges the pre-aggregation of the column in all chunk metas of the file.
+// No statistics are written for it, every call reads all chunk metas, so callers
+// pruning files repeatedly should keep the result, the file never changes.
+// min and max are returned for integer, float and boolean columns only: the pre-aggregation
+// of string columns holds no min and max, so only the null count is returned for them.
+// Rows of the chunks without the column are counted as null, min and max are nil if all values are null
+func (f *tsspFile) ColumnStats(field string) (min, max interface{}, nullCount int64, err error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.stopped() {
+		return nil, nil, 0, errFileClosed
+	}
+
+	found := false
+	var metas []ChunkMeta
+	tb := acquireTimePreAggBuilder()
+	defer tb.release()
+	for i := 0; i < int(f.reader.FileStat().MetaIndexItemNum()); i++ {
+		mi, err := f.reader.MetaIndexAt(i)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		metas, err = f.reader.ReadChunkMetaData(i, mi, metas[:0])
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		for j := range metas {
+			cm := &metas[j]
+			rows := int64(cm.Rows(tb))
+			idx := cm.columnIndexByName(field)
+			if idx < 0 {
+				nullCount += rows
+				continue
+			}
+
+			found = true
+			col := &cm.colMeta[idx]
+			ab := acquireColumnBuilder(int(col.ty))
+			if _, err = ab.unmarshal(col.preAgg); err != nil {
+				ab.release()
+				return nil, nil, 0, err
+			}
+			nullCount += rows - ab.count()
+			if ab.count() > 0 && col.ty != influx.Field_Type_String {
+				v, _ := ab.min()
+				min = mergeColumnStatsValue(min, v, true)
+				v, _ = ab.max()
+				max = mergeColumnStatsValue(max, v, false)
+			}
+			ab.release()
+		}
+	}
+
+	if !found {
+		return nil, nil, 0, fmt.Errorf("column %s not found in %s", field, f.reader.Path())
+	}
+	return min, max, nullCount, nil
+}
+
+func mergeColumnStatsValue(cur, v interface{}, isMin bool) interface{} {
+	if cur == nil {
+		return v
+	}
+
+	var less bool
+	switch val := v.(type) {
+	case int64:
+		less = val < cur.(int64)
+	case float64:
+		less = val < cur.(float64)
+	case bool:
+		less = !val && cur.(bool)
+	default:
+		return cur
+	}
+
+	if less == isMin {
+		return v
+	}
+	return cur
+}
+
 func (f *tsspFile) ReadMetaBlock(metaIdx int, id uint64, offset int64, size uint32, count uint32, dst *[]byte) ([]byte, error) {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
@@ -825,20 +1775,115 @@ type FilesInfo struct {
 	toLevel      uint16
 }
 
+func (fi *FilesInfo) EstimateSize() int {
+	return fi.estimateSize
+}
+
+func (fi *FilesInfo) MaxChunkRows() int {
+	return fi.maxChunkRows
+}
+
+func (fi *FilesInfo) AvgChunkRows() int {
+	return fi.avgChunkRows
+}
+
+func (fi *FilesInfo) MaxColumns() int {
+	return fi.maxColumns
+}
+
+func (fi *FilesInfo) MaxChunkN() int {
+	return fi.maxChunkN
+}
+
 func GetTmpTsspFileSuffix() string {
 	return tmpTsspFileSuffix
 }
 
+// EvictHook is called after the in-memory blocks of a file are freed, freed is the size of the memory released
+type EvictHook func(f TSSPFile, freed int64)
+
+var onEvict atomic.Value
+
+// SetOnEvict sets the hook called after a file is evicted from memory, nil removes the hook.
+// The hook is called without holding the lock of the file, it can access the file
+func SetOnEvict(fn EvictHook) {
+	onEvict.Store(fn)
+}
+
+var (
+	removeAttempts   int32 = 1
+	removeRetryDelay int64 = int64(100 * time.Millisecond)
+)
+
+// SetRemoveRetry sets how many times removing a tssp file is attempted, the n-th retry waits baseDelay<<(n-1).
+// It helps on storages returning transient errors such as NFS, the default is a single attempt
+func SetRemoveRetry(attempts int, baseDelay time.Duration) {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if baseDelay < 0 {
+		baseDelay = 0
+	}
+	atomic.StoreInt32(&removeAttempts, int32(attempts))
+	atomic.StoreInt64(&removeRetryDelay, int64(baseDelay))
+}
+
+// retryRemove removes name with remove, retrying with backoff according to SetRemoveRetry.
+// A file that does not exist is treated as removed
+func retryRemove(name string, remove func(string, ...fileops.FSOption) error, opt ...fileops.FSOption) error {
+	attempts := int(atomic.LoadInt32(&removeAttempts))
+	delay := time.Duration(atomic.LoadInt64(&removeRetryDelay))
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			log.Warn("retry removing file", zap.String("file", name), zap.Int("attempt", i+1), zap.Error(err))
+			time.Sleep(delay << (i - 1))
+		}
+		err = remove(name, opt...)
+		if err == nil || os.IsNotExist(err) {
+			return nil
+		}
+	}
+	return err
+}
+
+// fileOpLimiter bounds the number of files referenced by FileOperation at the same time,
+// it holds a nil limiter.Fixed if there is no limit
+var fileOpLimiter atomic.Value
+
+func init() {
+	fileOpLimiter.Store(limiter.Fixed(nil))
+	onEvict.Store(EvictHook(nil))
+}
+
+// SetMaxConcurrentFileOperations sets the maximum number of concurrent FileOperation calls,
+// n <= 0 means unlimited. Operations already running are not affected
+func SetMaxConcurrentFileOperations(n int) {
+	var l limiter.Fixed
+	if n > 0 {
+		l = limiter.NewFixed(n)
+	}
+	fileOpLimiter.Store(l)
+}
+
 func FileOperation(f TSSPFile, op func()) {
 	if op == nil {
 		return
 	}
 
+	l := fileOpLimiter.Load().(limiter.Fixed)
+	if l != nil {
+		l.Take()
+	}
 	f.Ref()
 	f.RefFileReader()
 	defer func() {
 		f.UnrefFileReader()
 		f.Unref()
+		if l != nil {
+			l.Release()
+		}
 	}()
 	op()
 }
@@ -19,6 +19,7 @@ package immutable
 import (
 	"fmt"
 	"math"
+	"os"
 	"sync"
 	"sync/atomic"
 
@@ -49,6 +50,8 @@ var (
 	errOpenFail   = func(v ...interface{}) error { return errno.NewError(errno.OpenFileFailed, v...) }
 	errMapFail    = func(v ...interface{}) error { return errno.NewError(errno.MapFileFailed, v...) }
 	errCloseFail  = func(v ...interface{}) error { return errno.NewError(errno.MapFileFailed, v...) }
+
+	errTrailerCorrupt = func(v ...interface{}) error { return errno.NewError(errno.TsspTrailerCorrupt, v...) }
 )
 
 type TSSPFileReader interface {
@@ -116,12 +119,14 @@ func CreateTSSPFileReader(size int64, fd fileops.File, trailer *Trailer, tb *Tab
 
 	var bloomFilter *bloom.Filter
 	var err error
-	// copy bloom filter content for reader, buf does not share
-	bloomBuf := make([]byte, len(tb.bloomFilter))
-	copy(bloomBuf, tb.bloomFilter)
+	if len(tb.bloomFilter) > 0 {
+		// copy bloom filter content for reader, buf does not share
+		bloomBuf := make([]byte, len(tb.bloomFilter))
+		copy(bloomBuf, tb.bloomFilter)
 
-	if bloomFilter, err = bloom.NewFilterBuffer(bloomBuf, trailer.bloomK); err != nil {
-		return nil, err
+		if bloomFilter, err = bloom.NewFilterBuffer(bloomBuf, trailer.bloomK); err != nil {
+			return nil, err
+		}
 	}
 
 	if !tmp {
@@ -188,6 +193,9 @@ func NewTSSPFileReader(name string, lockPath *string) (*tsspFileReader, error) {
 	fi, err := fileops.Stat(name)
 	if err != nil {
 		log.Error("stat file failed", zap.String("file", name), zap.Error(err))
+		if os.IsNotExist(err) {
+			return nil, errno.NewError(errno.TsspFileNotExist, name).SetCause(err)
+		}
 		err = errOpenFail(name, err)
 		return nil, err
 	}
@@ -229,6 +237,12 @@ func NewTSSPFileReader(name string, lockPath *string) (*tsspFileReader, error) {
 		return nil, err
 	}
 	version := numberenc.UnmarshalUint64(hb[len(tableMagic):])
+	if ok, reason := checkFileVersion(version); !ok {
+		_ = dr.Close()
+		err = errno.NewError(errno.TsspFileVersionIncompatible, name, reason)
+		log.Warn(err.Error())
+		return nil, err
+	}
 
 	ft := footer[:]
 	fb, err := dr.ReadAt(size-8, 8, &ft)
@@ -243,7 +257,7 @@ func NewTSSPFileReader(name string, lockPath *string) (*tsspFileReader, error) {
 		_ = dr.Close()
 		err = fmt.Errorf("invalid file footer offset, file(%v), offset(%v), file size(%v)", name, trailOff, size)
 		log.Error(err.Error())
-		err = errOpenFail(err)
+		err = errTrailerCorrupt(name, err)
 		return nil, err
 	}
 
@@ -260,7 +274,7 @@ func NewTSSPFileReader(name string, lockPath *string) (*tsspFileReader, error) {
 	tr := &r.trailer
 	_, err = tr.unmarshal(tb)
 	if err != nil {
-		err = errCreateFail(dr.Name(), err)
+		err = errTrailerCorrupt(dr.Name(), err)
 		_ = dr.Close()
 		log.Error("unmarshal file trailer fail", zap.Error(err))
 		return nil, err
@@ -276,6 +290,52 @@ func NewTSSPFileReader(name string, lockPath *string) (*tsspFileReader, error) {
 	return r, nil
 }
 
+// verifyFileSize re-reads the footer and trailer of the file on disk,
+// and checks the sizes of the sections declared in the trailer against the actual file length.
+// It detects files truncated by a crash in the middle of writing
+func verifyFileSize(name string) error {
+	fd, err := fileops.Open(name, fileops.FileLockOption(""), fileops.FilePriorityOption(fileops.IO_PRIORITY_NORMAL))
+	if err != nil {
+		return errOpenFail(name, err)
+	}
+	defer util.MustClose(fd)
+
+	fi, err := fd.Stat()
+	if err != nil {
+		return errOpenFail(name, err)
+	}
+	size := fi.Size()
+	if size < minTableSize() {
+		return errTrailerCorrupt(name, fmt.Errorf("invalid file size %d", size))
+	}
+
+	var footer [8]byte
+	if _, err = fd.ReadAt(footer[:], size-8); err != nil {
+		return errTrailerCorrupt(name, err)
+	}
+	trailOff := numberenc.UnmarshalInt64(footer[:])
+	if trailOff < int64(len(tableMagic)+8) || trailOff > size-8 {
+		return errTrailerCorrupt(name, fmt.Errorf("invalid file footer offset %d, file size %d", trailOff, size))
+	}
+
+	buf := make([]byte, size-8-trailOff)
+	if _, err = fd.ReadAt(buf, trailOff); err != nil {
+		return errTrailerCorrupt(name, err)
+	}
+	tr := &Trailer{}
+	if _, err = tr.unmarshal(buf); err != nil {
+		return errTrailerCorrupt(name, err)
+	}
+
+	end, _ := tr.idTimeOffsetSize()
+	end += tr.idTimeSize
+	if tr.dataOffset < int64(len(tableMagic)+8) || tr.dataSize < 0 || end != trailOff {
+		return errTrailerCorrupt(name, fmt.Errorf("sections declared in trailer end at %d, trailer offset %d, file size %d",
+			end, trailOff, size))
+	}
+	return nil
+}
+
 func (r *tsspFileReader) copyMetaIndex(items []MetaIndex) {
 	if cap(r.metaIndexItems) < len(items) {
 		r.metaIndexItems = make([]MetaIndex, len(items))
@@ -558,6 +618,10 @@ func (r *tsspFileReader) GetTSSPFileBytes(offset int64, size uint32, buf *[]byte
 }
 
 func (r *tsspFileReader) ReadData(offset int64, size uint32, dst *[]byte) ([]byte, error) {
+	if b, ok := blockCache.Get(r.Path(), offset, size, dst); ok {
+		return b, nil
+	}
+
 	if err := r.lazyInit(); err != nil {
 		errInfo := errno.NewError(errno.LoadFilesFailed)
 		log.Error("Read", zap.Error(errInfo))
@@ -574,6 +638,7 @@ func (r *tsspFileReader) ReadData(offset int64, size uint32, dst *[]byte) ([]byt
 		return nil, err
 	}
 
+	blockCache.Add(r.Path(), offset, b)
 	return b, nil
 }
 
@@ -780,19 +845,41 @@ func (r *tsspFileReader) ContainsValue(id uint64, tm record.TimeRange) (bool, er
 		return false, nil
 	}
 
-	if err := r.lazyInit(); err != nil {
+	bf, err := r.bloomFilter()
+	if err != nil {
 		errInfo := errno.NewError(errno.LoadFilesFailed)
 		log.Error("Contains", zap.Error(errInfo))
 		return false, err
 	}
 
-	if !r.bloom.Contains(record.Uint64ToBytes(id)) {
+	if bf != nil && !bf.Contains(record.Uint64ToBytes(id)) {
 		return false, nil
 	}
 
 	return true, nil
 }
 
+// bloomFilter returns the id bloom filter persisted in the file, loading only that block
+// if the components of the file are not loaded yet, nil means the file has no bloom filter block
+func (r *tsspFileReader) bloomFilter() (*bloom.Filter, error) {
+	if r.initialized() {
+		return r.bloom, nil
+	}
+
+	r.openMu.Lock()
+	defer r.openMu.Unlock()
+
+	if !r.r.IsOpen() {
+		if err := r.loadDiskFileReader(); err != nil {
+			return nil, errLoadFail(r.Path(), err)
+		}
+	}
+	if err := r.loadBloomFilter(); err != nil {
+		return nil, errLoadFail(r.Path(), err)
+	}
+	return r.bloom, nil
+}
+
 func (r *tsspFileReader) ContainsTime(tm record.TimeRange) (bool, error) {
 	return tm.Overlaps(r.trailer.minTime, r.trailer.maxTime), nil
 }
@@ -830,6 +917,7 @@ func (r *tsspFileReader) InMemSize() int64 {
 }
 
 func (r *tsspFileReader) Close() error {
+	blockCache.RemoveFile(r.Path())
 	err := r.r.Close()
 
 	r.inMemBlock.FreeMemory()
@@ -840,6 +928,7 @@ func (r *tsspFileReader) Close() error {
 }
 
 func (r *tsspFileReader) Rename(newName string) error {
+	blockCache.RemoveFile(r.Path())
 	return r.r.Rename(newName)
 }
 
@@ -852,10 +941,11 @@ func (r *tsspFileReader) loadDiskFileReader() error {
 }
 
 func (r *tsspFileReader) loadBloomFilter() error {
-	if r.bloom != nil {
+	tr := &r.trailer
+	if r.bloom != nil || tr.bloomSize == 0 {
+		// files written without a bloom filter block are checked by the meta index only
 		return nil
 	}
-	tr := &r.trailer
 	// load bloom filter
 	bloomBuf := make([]byte, tr.bloomSize)
 	metaIndexOff, _ := r.trailer.metaIndexOffsetSize()
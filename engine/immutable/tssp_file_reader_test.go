@@ -17,7 +17,9 @@ limitations under the License.
 package immutable
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -25,13 +27,17 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
 	"github.com/influxdata/influxdb/pkg/bloom"
 	"github.com/openGemini/openGemini/engine/immutable/encoding"
+	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/interruptsignal"
+	"github.com/openGemini/openGemini/lib/numberenc"
 	"github.com/openGemini/openGemini/lib/rand"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/util"
@@ -1670,6 +1676,110 @@ func TestCompareFile(t *testing.T) {
 	assert.True(t, !compareFileByDescend(f3, f4))
 }
 
+func TestTSSPFile_MinMaxTimeCache(t *testing.T) {
+	f := genTsspFile("00000001-0000-00000000.tssp")
+	mr := f.(*tsspFile).reader.(*mockTSSPFileReader)
+	calls := 0
+	mr.MinMaxTimeFn = func() (int64, int64, error) {
+		calls++
+		return 10, 20, nil
+	}
+	mr.RenameFn = func(string) error { return nil }
+
+	for i := 0; i < 10; i++ {
+		min, max, err := f.MinMaxTime()
+		require.NoError(t, err)
+		require.Equal(t, []int64{10, 20}, []int64{min, max})
+	}
+	require.Equal(t, 1, calls)
+
+	require.NoError(t, f.Rename("00000002-0000-00000000.tssp"))
+	_, _, err := f.MinMaxTime()
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	// errors are not cached
+	require.NoError(t, f.Rename("00000003-0000-00000000.tssp"))
+	mr.MinMaxTimeFn = func() (int64, int64, error) {
+		calls++
+		return 0, 0, fmt.Errorf("read failed")
+	}
+	for i := 0; i < 2; i++ {
+		_, _, err = f.MinMaxTime()
+		require.EqualError(t, err, "read failed")
+	}
+	require.Equal(t, 4, calls)
+
+	f.Stop()
+	_, _, err = f.MinMaxTime()
+	require.Equal(t, errFileClosed, err)
+}
+
+func TestTSSPFile_WaitIdle(t *testing.T) {
+	f := genTsspFile("00000001-0000-00000000.tssp").(*tsspFile)
+	require.NoError(t, f.WaitIdle(context.Background()))
+
+	f.Ref()
+	f.Ref()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	require.Equal(t, context.DeadlineExceeded, f.WaitIdle(ctx))
+	cancel()
+
+	done := make(chan error)
+	go func() {
+		done <- f.WaitIdle(context.Background())
+	}()
+
+	f.Unref()
+	select {
+	case <-done:
+		t.Fatal("WaitIdle returned while the file is in use")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	f.Unref()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("WaitIdle is not woken up")
+	}
+	require.Equal(t, int32(0), atomic.LoadInt32(&f.idleWaiters))
+}
+
+func TestTSSPFile_CloneWithTombstones(t *testing.T) {
+	f := genTsspFile("00000001-0000-00000000.tssp")
+	mr := f.(*tsspFile).reader.(*mockTSSPFileReader)
+	var closed int32
+	mr.CloseFn = func() error { atomic.AddInt32(&closed, 1); return nil }
+	mr.InMemSizeFn = func() int64 { return 0 }
+
+	ts := []TombstoneFile{{path: "00000001-0000-00000000.tombstone"}}
+	clone, err := f.(*tsspFile).CloneWithTombstones(ts)
+	require.NoError(t, err)
+	require.True(t, clone.HasTombstones())
+	require.Equal(t, "00000001-0000-00000000.tombstone", clone.TombstoneFiles()[0].path)
+	require.Equal(t, f.Path(), clone.Path())
+	// the clone holds no reference to the file
+	require.False(t, f.Inuse())
+
+	empty, err := f.(*tsspFile).CloneWithTombstones(nil)
+	require.NoError(t, err)
+	require.False(t, empty.HasTombstones())
+	require.NoError(t, empty.Close())
+
+	// the shared reader is closed by the last clone
+	require.NoError(t, f.Close())
+	require.Equal(t, int32(0), atomic.LoadInt32(&closed))
+
+	require.NoError(t, clone.Close())
+	require.NoError(t, clone.Close())
+	require.Equal(t, int32(1), atomic.LoadInt32(&closed))
+
+	_, err = f.(*tsspFile).CloneWithTombstones(ts)
+	require.Equal(t, errFileClosed, err)
+}
+
 func TestFreeMemory(t *testing.T) {
 	f1 := genTsspFile("00000001-0000-00000000.tssp")
 
@@ -1746,3 +1856,1111 @@ func TestReadError(t *testing.T) {
 	_, err = tf.reader.ReadDataBlock(0, 2000, &buf)
 	require.NotEmpty(t, err)
 }
+
+func TestOpenTSSPFileErrno(t *testing.T) {
+	dir := t.TempDir()
+	lockPath := ""
+
+	_, err := OpenTSSPFile(filepath.Join(dir, "invalid.tssp"), &lockPath, true, false)
+	require.True(t, errno.Equal(err, errno.InvalidTsspFileName))
+
+	_, err = OpenTSSPFile(filepath.Join(dir, "00000001-0000-00000000.tssp"), &lockPath, true, false)
+	require.True(t, errno.Equal(err, errno.TsspFileNotExist))
+	require.True(t, errors.Is(err, os.ErrNotExist))
+
+	// footer holds an invalid trailer offset
+	buf := make([]byte, minTableSize()+8)
+	copy(buf, tableMagic)
+	numberenc.MarshalUint64Copy(buf[len(tableMagic):], version)
+	for i := len(buf) - 8; i < len(buf); i++ {
+		buf[i] = 0xff
+	}
+	name := filepath.Join(dir, "00000002-0000-00000000.tssp")
+	require.NoError(t, os.WriteFile(name, buf, 0600))
+
+	_, err = OpenTSSPFile(name, &lockPath, true, false)
+	require.True(t, errno.Equal(err, errno.TsspTrailerCorrupt))
+}
+
+func TestTSSPFileVersionInfo(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 1, 10, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	for _, id := range ids {
+		require.NoError(t, msb.WriteData(id, data[id]))
+	}
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+
+	tf, ok := fs.Files()[0].(*tsspFile)
+	require.True(t, ok)
+	ver, compatible, reason := tf.FileVersionInfo()
+	require.Equal(t, version, ver)
+	require.True(t, compatible)
+	require.Equal(t, "", reason)
+
+	// the same file written by a newer binary
+	buf, err := os.ReadFile(tf.Path())
+	require.NoError(t, err)
+	numberenc.MarshalUint64Copy(buf[len(tableMagic):], version+1)
+	name := filepath.Join(dir, "00000002-0000-00000000.tssp")
+	require.NoError(t, os.WriteFile(name, buf, 0600))
+
+	_, err = OpenTSSPFile(name, &lockPath, true, false)
+	require.True(t, errno.Equal(err, errno.TsspFileVersionIncompatible))
+	require.Contains(t, err.Error(), "file version 3 is newer than the latest readable version 2")
+
+	compatible, reason = checkFileVersion(1)
+	require.False(t, compatible)
+	require.Equal(t, "file version 1 is not readable, readable versions: [2]", reason)
+}
+
+func TestReopenAfterFreeFileHandle(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 1, 10, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	for _, id := range ids {
+		require.NoError(t, msb.WriteData(id, data[id]))
+	}
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+
+	f := fs.Files()[0]
+	tf, ok := f.(*tsspFile)
+	require.True(t, ok)
+	fr, ok := tf.reader.(*tsspFileReader)
+	require.True(t, ok)
+
+	readMetaIndex := func() {
+		midx, err := f.MetaIndexAt(0)
+		require.NoError(t, err)
+		_, err = f.ReadChunkMetaData(0, midx, nil)
+		require.NoError(t, err)
+	}
+
+	readMetaIndex()
+	require.NoError(t, f.FreeFileHandle())
+	require.False(t, fr.r.IsOpen())
+	require.NoError(t, f.Reopen())
+	require.True(t, fr.r.IsOpen())
+	readMetaIndex()
+
+	// reads issued after FreeFileHandle reopen the file transparently
+	require.NoError(t, f.FreeFileHandle())
+	require.False(t, fr.r.IsOpen())
+	readMetaIndex()
+	require.True(t, fr.r.IsOpen())
+
+	f.Stop()
+	require.EqualError(t, f.Reopen(), errFileClosed.Error())
+}
+
+func TestStopFilesAndWait(t *testing.T) {
+	files := NewTSSPFiles()
+	f1 := genTsspFile("00000001-0000-00000000.tssp")
+	f2 := genTsspFile("00000002-0000-00000000.tssp")
+	files.Append(f1)
+	files.Append(f2)
+
+	f1.Ref()
+	err := files.StopFilesAndWait(10 * time.Millisecond)
+	require.NotEmpty(t, err)
+	require.Contains(t, err.Error(), "00000001-0000-00000000")
+	require.NotContains(t, err.Error(), "00000002-0000-00000000")
+	// nothing is left waiting for the file after the timeout
+	require.Equal(t, int32(0), atomic.LoadInt32(&f1.(*tsspFile).idleWaiters))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		f1.Unref()
+	}()
+	require.NoError(t, files.StopFilesAndWait(5*time.Second))
+	require.False(t, f1.Inuse())
+}
+
+func TestLevelMemStats(t *testing.T) {
+	const level = uint16(5)
+	newFile := func(name string, order bool, size int64) TSSPFile {
+		f := genTsspFile(name)
+		tf := f.(*tsspFile)
+		tf.name.SetOrder(order)
+		mr := tf.reader.(*mockTSSPFileReader)
+		mr.LoadIntoMemoryFn = func() error { return nil }
+		mr.InMemSizeFn = func() int64 { return size }
+		mr.FreeMemoryFn = func() int64 { return size }
+		return f
+	}
+
+	f1 := newFile("00000001-0005-00000000.tssp", true, 100)
+	f2 := newFile("00000002-0005-00000000.tssp", false, 30)
+
+	before := LevelMemStats()[level]
+	require.NoError(t, f1.LoadIntoMemory())
+	require.NoError(t, f2.LoadIntoMemory())
+
+	stat := LevelMemStats()[level]
+	require.Equal(t, before.EvictListLen+2, stat.EvictListLen)
+	require.Equal(t, before.MemSize+130, stat.MemSize)
+	require.Equal(t, before.OrderMemSize+100, stat.OrderMemSize)
+	require.Equal(t, before.UnOrderMemSize+30, stat.UnOrderMemSize)
+
+	require.Equal(t, int64(100), f1.Free(true))
+	require.Equal(t, int64(30), f2.Free(true))
+
+	stat = LevelMemStats()[level]
+	require.Equal(t, before, stat)
+}
+
+func TestLevelMemBudget(t *testing.T) {
+	const level = uint16(3)
+	newFile := func(name string) TSSPFile {
+		f := genTsspFile(name)
+		tf := f.(*tsspFile)
+		tf.name.SetOrder(true)
+		mr := tf.reader.(*mockTSSPFileReader)
+		var inMem int64
+		mr.LoadIntoMemoryFn = func() error { inMem = 100; return nil }
+		mr.InMemSizeFn = func() int64 { return inMem }
+		mr.FreeMemoryFn = func() int64 { size := inMem; inMem = 0; return size }
+		return f
+	}
+
+	f1 := newFile("00000001-0003-00000000.tssp")
+	f2 := newFile("00000002-0003-00000000.tssp")
+	f3 := newFile("00000003-0003-00000000.tssp")
+	before := LevelMemStats()[level]
+	for _, f := range []TSSPFile{f1, f2, f3} {
+		require.NoError(t, f.LoadIntoMemory())
+	}
+	f1.Ref()
+
+	SetLevelMemBudget(level, before.MemSize+250)
+	defer SetLevelMemBudget(level, 0)
+	stat := LevelMemStats()[level]
+	require.Equal(t, before.MemSize+250, stat.MemBudget)
+	require.Equal(t, before.MemSize+300, stat.MemSize)
+
+	// f1 is the least recently used but in use, f2 is enough to get back under the budget
+	nodeEvictCtx.enforceLevelMemBudgets()
+	require.Equal(t, int64(100), f1.InMemSize())
+	require.Equal(t, int64(0), f2.InMemSize())
+	require.Equal(t, int64(100), f3.InMemSize())
+	stat = LevelMemStats()[level]
+	require.Equal(t, before.MemSize+200, stat.MemSize)
+	require.Equal(t, before.EvictListLen+2, stat.EvictListLen)
+
+	// under the budget, nothing is evicted
+	nodeEvictCtx.enforceLevelMemBudgets()
+	require.Equal(t, int64(100), f3.InMemSize())
+
+	f1.Unref()
+	require.Equal(t, int64(100), f1.Free(true))
+	require.Equal(t, int64(100), f3.Free(true))
+	SetLevelMemBudget(level, 0)
+	require.Equal(t, before, LevelMemStats()[level])
+}
+
+func TestTSSPFile_OpenAfterFree(t *testing.T) {
+	const level = uint16(5)
+	f := genTsspFile("00000001-0005-00000000.tssp")
+	tf := f.(*tsspFile)
+	tf.name.SetOrder(true)
+
+	var inMem int64
+	var loads, opens int
+	mr := tf.reader.(*mockTSSPFileReader)
+	mr.LoadComponentsFn = func() error { opens++; return nil }
+	mr.LoadIntoMemoryFn = func() error { loads++; inMem = 100; return nil }
+	mr.InMemSizeFn = func() int64 { return inMem }
+	mr.FreeMemoryFn = func() int64 { size := inMem; inMem = 0; return size }
+
+	before := LevelMemStats()[level]
+
+	// nothing was freed, only the file handle is reacquired
+	require.NoError(t, f.Open())
+	require.Equal(t, 1, opens)
+	require.Equal(t, 0, loads)
+
+	require.NoError(t, f.LoadIntoMemory())
+	require.Equal(t, int64(100), f.Free(true))
+	require.Equal(t, before, LevelMemStats()[level])
+
+	require.NoError(t, f.Open())
+	require.Equal(t, 2, opens)
+	require.Equal(t, 2, loads)
+	stat := LevelMemStats()[level]
+	require.Equal(t, before.EvictListLen+1, stat.EvictListLen)
+	require.Equal(t, before.OrderMemSize+100, stat.OrderMemSize)
+
+	// the data is reloaded only once
+	require.NoError(t, f.Open())
+	require.Equal(t, 2, loads)
+
+	// reload failure is retried by the next Open
+	require.Equal(t, int64(100), f.Free(true))
+	mr.LoadIntoMemoryFn = func() error { return fmt.Errorf("load failed") }
+	require.EqualError(t, f.Open(), "load failed")
+	require.Equal(t, before, LevelMemStats()[level])
+	mr.LoadIntoMemoryFn = func() error { loads++; inMem = 100; return nil }
+	require.NoError(t, f.Open())
+	require.Equal(t, 3, loads)
+
+	require.Equal(t, int64(100), f.Free(true))
+	require.Equal(t, before, LevelMemStats()[level])
+
+	f.Stop()
+	require.EqualError(t, f.Open(), errFileClosed.Error())
+}
+
+func TestTSSPFile_OnEvict(t *testing.T) {
+	type evicted struct {
+		path  string
+		freed int64
+	}
+	var got []evicted
+	SetOnEvict(func(f TSSPFile, freed int64) {
+		// the lock of the file is not held
+		_ = f.IsOrder()
+		got = append(got, evicted{f.Path(), freed})
+	})
+	defer SetOnEvict(nil)
+
+	f := genTsspFile("00000001-0005-00000000.tssp")
+	tf := f.(*tsspFile)
+	var inMem int64
+	mr := tf.reader.(*mockTSSPFileReader)
+	mr.LoadIntoMemoryFn = func() error { inMem = 100; return nil }
+	mr.InMemSizeFn = func() int64 { return inMem }
+	mr.FreeMemoryFn = func() int64 { size := inMem; inMem = 0; return size }
+
+	// nothing is freed
+	require.Equal(t, int64(0), f.Free(true))
+	require.Empty(t, got)
+
+	require.NoError(t, f.LoadIntoMemory())
+	require.Equal(t, int64(100), f.Free(true))
+	require.Equal(t, []evicted{{f.Path(), 100}}, got)
+
+	SetOnEvict(nil)
+	require.NoError(t, f.LoadIntoMemory())
+	require.Equal(t, int64(100), f.Free(true))
+	require.Equal(t, 1, len(got))
+}
+
+func TestTSSPFilesLoadIntoMemory(t *testing.T) {
+	const fileSize = 100
+	files := NewTSSPFiles()
+	for i := 1; i <= 5; i++ {
+		f := genTsspFile(fmt.Sprintf("%08x-0006-00000000.tssp", i))
+		mr := f.(*tsspFile).reader.(*mockTSSPFileReader)
+		var loaded int64
+		mr.FileSizeFn = func() int64 { return fileSize }
+		mr.InMemSizeFn = func() int64 { return atomic.LoadInt64(&loaded) }
+		mr.LoadIntoMemoryFn = func() error {
+			atomic.StoreInt64(&loaded, fileSize)
+			return nil
+		}
+		mr.FreeMemoryFn = func() int64 { return atomic.SwapInt64(&loaded, 0) }
+		files.Append(f)
+	}
+
+	before := LevelMemStats()[6]
+	require.NoError(t, files.LoadIntoMemory(2*fileSize+50, 3))
+
+	var n int
+	for _, f := range files.Files() {
+		if f.InMemSize() > 0 {
+			n++
+		}
+	}
+	require.Equal(t, 2, n)
+
+	stat := LevelMemStats()[6]
+	require.Equal(t, before.EvictListLen+2, stat.EvictListLen)
+	require.Equal(t, before.MemSize+2*fileSize, stat.MemSize)
+
+	for _, f := range files.Files() {
+		if f.InMemSize() > 0 {
+			f.Free(true)
+		}
+	}
+	require.Equal(t, before, LevelMemStats()[6])
+
+	mr := files.Files()[0].(*tsspFile).reader.(*mockTSSPFileReader)
+	mr.LoadIntoMemoryFn = func() error { return fmt.Errorf("load failed") }
+	require.EqualError(t, files.LoadIntoMemory(10*fileSize, 2), "load failed")
+	for _, f := range files.Files() {
+		if f.InMemSize() > 0 {
+			f.Free(true)
+		}
+	}
+}
+
+func TestTSSPFileColumnStats(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	rec1 := record.NewRecordBuilder([]record.Field{
+		{Name: "field1_int", Type: influx.Field_Type_Int},
+		{Name: "field2_float", Type: influx.Field_Type_Float},
+		{Name: "field3_string", Type: influx.Field_Type_String},
+		{Name: "field4_bool", Type: influx.Field_Type_Boolean},
+		{Name: record.TimeField, Type: influx.Field_Type_Int},
+	})
+	rec1.ColVals[0].AppendInteger(5)
+	rec1.ColVals[0].AppendIntegerNull()
+	rec1.ColVals[0].AppendInteger(-3)
+	rec1.ColVals[0].AppendInteger(10)
+	rec1.ColVals[1].AppendFloat(1.5)
+	rec1.ColVals[1].AppendFloat(2.5)
+	rec1.ColVals[1].AppendFloatNull()
+	rec1.ColVals[1].AppendFloat(-0.5)
+	rec1.ColVals[2].AppendString("a")
+	rec1.ColVals[2].AppendStringNull()
+	rec1.ColVals[2].AppendString("b")
+	rec1.ColVals[2].AppendString("c")
+	rec1.ColVals[3].AppendBoolean(false)
+	rec1.ColVals[3].AppendBoolean(true)
+	rec1.ColVals[3].AppendBooleanNull()
+	rec1.ColVals[3].AppendBoolean(false)
+	for i := int64(1); i <= 4; i++ {
+		rec1.ColVals[4].AppendInteger(i)
+	}
+
+	// the series has no string and boolean columns
+	rec2 := record.NewRecordBuilder([]record.Field{
+		{Name: "field1_int", Type: influx.Field_Type_Int},
+		{Name: "field2_float", Type: influx.Field_Type_Float},
+		{Name: record.TimeField, Type: influx.Field_Type_Int},
+	})
+	rec2.ColVals[0].AppendInteger(100)
+	rec2.ColVals[0].AppendInteger(7)
+	rec2.ColVals[0].AppendIntegerNull()
+	rec2.ColVals[1].AppendFloat(3.5)
+	rec2.ColVals[1].AppendFloat(0)
+	rec2.ColVals[1].AppendFloat(1)
+	for i := int64(1); i <= 3; i++ {
+		rec2.ColVals[2].AppendInteger(i)
+	}
+
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	require.NoError(t, msb.WriteData(1, rec1))
+	require.NoError(t, msb.WriteData(2, rec2))
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+
+	tf, ok := fs.Files()[0].(*tsspFile)
+	require.True(t, ok)
+
+	var tests = []struct {
+		field     string
+		min, max  interface{}
+		nullCount int64
+	}{
+		{"field1_int", int64(-3), int64(100), 2},
+		{"field2_float", -0.5, 3.5, 1},
+		// string columns report the null count only
+		{"field3_string", nil, nil, 4},
+		{"field4_bool", false, true, 4},
+	}
+	for _, tt := range tests {
+		min, max, nullCount, err := tf.ColumnStats(tt.field)
+		require.NoError(t, err, tt.field)
+		require.Equal(t, tt.min, min, tt.field)
+		require.Equal(t, tt.max, max, tt.field)
+		require.Equal(t, tt.nullCount, nullCount, tt.field)
+	}
+
+	_, _, _, err := tf.ColumnStats("field5_none")
+	require.EqualError(t, err, fmt.Sprintf("column field5_none not found in %s", tf.Path()))
+
+	_, _, _, err = tf.ColumnStats(record.TimeField)
+	require.Error(t, err)
+
+	tf.Stop()
+	_, _, _, err = tf.ColumnStats("field1_int")
+	require.Equal(t, errFileClosed, err)
+}
+
+func TestTSSPFileSummary(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 10, 100, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 2, fileName, 0, store.Sequencer(), 2)
+	for _, id := range ids {
+		require.NoError(t, msb.WriteData(id, data[id]))
+	}
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+
+	tf, ok := fs.Files()[0].(*tsspFile)
+	require.True(t, ok)
+
+	s, err := tf.Summary()
+	require.NoError(t, err)
+
+	minTime, maxTime, err := tf.MinMaxTime()
+	require.NoError(t, err)
+	minId, maxId, err := tf.MinMaxSeriesID()
+	require.NoError(t, err)
+	require.Equal(t, FileSummary{
+		MinTime:          minTime,
+		MaxTime:          maxTime,
+		MinSeriesID:      minId,
+		MaxSeriesID:      maxId,
+		MetaIndexItemNum: tf.MetaIndexItemNum(),
+		FileSize:         tf.FileSize(),
+		InMemSize:        tf.InMemSize(),
+	}, s)
+	require.Equal(t, ids[0], s.MinSeriesID)
+	require.Equal(t, ids[len(ids)-1], s.MaxSeriesID)
+	require.True(t, s.MetaIndexItemNum > 0)
+	require.True(t, s.FileSize > 0)
+
+	tf.Stop()
+	_, err = tf.Summary()
+	require.Equal(t, errFileClosed, err)
+}
+
+func TestPlanCompaction(t *testing.T) {
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore("", &lockPath, &tier, false, NewConfig())
+	genFiles := func(names ...string) *TSSPFiles {
+		delete(store.Order, "mst")
+		for _, name := range names {
+			f := genTsspFile(filepath.Join("/data/mst", name))
+			require.NotNil(t, f, name)
+			store.addTSSPFile(true, f, "mst")
+		}
+		return store.tableFiles("mst", true)
+	}
+	paths := func(names ...string) []string {
+		for i := range names {
+			names[i] = filepath.Join("/data/mst", names[i])
+		}
+		return names
+	}
+
+	// fully compacted
+	fs := genFiles("00000001-0002-00000000.tssp")
+	require.Empty(t, PlanCompaction(fs, CompactLevels))
+	fs = genFiles(
+		"00000001-0002-00000000.tssp",
+		"00000001-0002-00000001.tssp",
+		"00000001-0002-00000002.tssp",
+	)
+	require.Empty(t, PlanCompaction(fs, CompactLevels))
+
+	level1 := []string{
+		"00000001-0001-00000000.tssp",
+		"00000009-0001-00000000.tssp",
+		"00000011-0001-00000000.tssp",
+		"00000019-0001-00000000.tssp",
+	}
+	var level0 []string
+	for i := 0x21; i <= 0x28; i++ {
+		level0 = append(level0, fmt.Sprintf("%08x-0000-00000000.tssp", i))
+	}
+	fs = genFiles(append(append([]string{}, level1...), level0...)...)
+
+	plans := PlanCompaction(fs, CompactLevels)
+	require.Equal(t, 2, len(plans))
+	require.Equal(t, "mst", plans[0].name)
+	require.Equal(t, uint16(1), plans[0].toLevel)
+	require.Equal(t, paths(level0...), plans[0].group)
+	require.Equal(t, uint16(2), plans[1].toLevel)
+	require.Equal(t, paths(level1...), plans[1].group)
+
+	plans = PlanCompaction(fs, 1)
+	require.Equal(t, 1, len(plans))
+	require.Equal(t, uint16(1), plans[0].toLevel)
+	require.Empty(t, PlanCompaction(fs, 0))
+
+	// the files are neither changed nor referenced, and can still be compacted
+	require.Equal(t, len(level0)+len(level1), fs.Len())
+	for _, f := range fs.Files() {
+		require.Equal(t, int32(1), f.(*tsspFile).ref)
+	}
+	plans = store.mmsPlan("mst", fs, 0, LeveLMinGroupFiles[0], nil)
+	require.Equal(t, 1, len(plans))
+	store.CompactDone(plans[0].group)
+	delete(store.Order, "mst")
+}
+
+func TestTSSPFilesTotalSize(t *testing.T) {
+	files := NewTSSPFiles()
+	require.Equal(t, int64(0), files.TotalSize())
+	require.Equal(t, int64(0), files.TotalInMemSize())
+
+	for i := 1; i <= 4; i++ {
+		f := genTsspFile(fmt.Sprintf("%08x-0001-00000000.tssp", i))
+		mr := f.(*tsspFile).reader.(*mockTSSPFileReader)
+		size := int64(i * 100)
+		mr.FileSizeFn = func() int64 { return size }
+		mr.InMemSizeFn = func() int64 { return size / 10 }
+		files.Append(f)
+	}
+
+	require.Equal(t, int64(100+200+300+400), files.TotalSize())
+	require.Equal(t, int64(10+20+30+40), files.TotalInMemSize())
+}
+
+func TestTSSPFilesSizeByLevel(t *testing.T) {
+	files := NewTSSPFiles()
+	require.Empty(t, files.SizeByLevel())
+
+	for i := 1; i <= 5; i++ {
+		level := uint16(i % 3)
+		f := genTsspFile(fmt.Sprintf("%08x-%04x-00000000.tssp", i, level))
+		mr := f.(*tsspFile).reader.(*mockTSSPFileReader)
+		size := int64(i * 100)
+		mr.FileSizeFn = func() int64 { return size }
+		mr.InMemSizeFn = func() int64 { return size / 10 }
+		files.Append(f)
+	}
+	require.Equal(t, map[uint16]int64{0: 300, 1: 100 + 400, 2: 200 + 500}, files.SizeByLevel())
+	require.Equal(t, int64(1500), files.TotalSize())
+
+	// closed files are skipped
+	files.Files()[0].Stop()
+	require.Equal(t, map[uint16]int64{0: 300, 1: 400, 2: 200 + 500}, files.SizeByLevel())
+	require.Equal(t, int64(1400), files.TotalSize())
+	require.Equal(t, int64(140), files.TotalInMemSize())
+}
+
+func TestTSSPFilesByLevel(t *testing.T) {
+	files := NewTSSPFiles()
+	require.Equal(t, uint16(0), files.MaxLevel())
+	require.Empty(t, files.FilesByLevel(0))
+
+	for i := 1; i <= 6; i++ {
+		files.Append(genTsspFile(fmt.Sprintf("%08x-%04x-00000000.tssp", i, i%3)))
+	}
+	require.Equal(t, uint16(2), files.MaxLevel())
+
+	level1 := files.FilesByLevel(1)
+	require.Equal(t, 2, len(level1))
+	for _, f := range level1 {
+		lv, _ := f.LevelAndSequence()
+		require.Equal(t, uint16(1), lv)
+		require.Equal(t, int32(2), f.(*tsspFile).ref)
+		f.UnrefFileReader()
+		f.Unref()
+	}
+	require.Empty(t, files.FilesByLevel(3))
+
+	// closed files are skipped
+	for _, f := range files.Files() {
+		if lv, _ := f.LevelAndSequence(); lv == 2 {
+			f.Stop()
+		}
+	}
+	require.Empty(t, files.FilesByLevel(2))
+	require.Equal(t, uint16(1), files.MaxLevel())
+}
+
+func TestTSSPFiles_Clone(t *testing.T) {
+	files := NewTSSPFiles()
+	for i := 1; i <= 3; i++ {
+		files.Append(genTsspFile(fmt.Sprintf("%08x-0000-00000000.tssp", i)))
+	}
+	files.Files()[2].Stop()
+
+	clone := files.Clone()
+	require.Equal(t, 2, len(clone))
+	for i, f := range clone {
+		require.Equal(t, files.Files()[i], f)
+		require.Equal(t, int32(2), f.(*tsspFile).ref)
+	}
+
+	files.Append(genTsspFile("00000004-0000-00000000.tssp"))
+	files.deleteFile(files.Files()[0])
+	require.Equal(t, 3, files.Len())
+	require.Equal(t, 2, len(clone))
+	require.Equal(t, "00000001-0000-00000000.tssp", clone[0].Path())
+	require.Equal(t, "00000002-0000-00000000.tssp", clone[1].Path())
+
+	ReleaseClone(clone)
+	for _, f := range clone {
+		require.Equal(t, int32(1), f.(*tsspFile).ref)
+	}
+}
+
+func TestRetryRemove(t *testing.T) {
+	var calls int
+	failing := func(n int, err error) func(string, ...fileops.FSOption) error {
+		calls = 0
+		return func(string, ...fileops.FSOption) error {
+			calls++
+			if calls <= n {
+				return err
+			}
+			return nil
+		}
+	}
+	errBusy := &os.PathError{Op: "remove", Path: "a.tssp", Err: syscall.EBUSY}
+
+	// a single attempt by default
+	require.Equal(t, errBusy, retryRemove("a.tssp", failing(1, errBusy)))
+	require.Equal(t, 1, calls)
+
+	SetRemoveRetry(3, time.Millisecond)
+	defer SetRemoveRetry(1, 100*time.Millisecond)
+	require.NoError(t, retryRemove("a.tssp", failing(2, errBusy)))
+	require.Equal(t, 3, calls)
+
+	require.Equal(t, errBusy, retryRemove("a.tssp", failing(3, errBusy)))
+	require.Equal(t, 3, calls)
+
+	// a missing file is removed already
+	require.NoError(t, retryRemove("a.tssp", failing(1, os.ErrNotExist)))
+	require.Equal(t, 1, calls)
+}
+
+func TestTSSPFiles_AllTombstoneFiles(t *testing.T) {
+	files := NewTSSPFiles()
+	var clones []TSSPFile
+	for i := 1; i <= 3; i++ {
+		f := genTsspFile(fmt.Sprintf("%08x-0000-00000000.tssp", i))
+		var ts []TombstoneFile
+		if i != 2 {
+			ts = []TombstoneFile{{path: fmt.Sprintf("%08x-0000-00000000.tombstone", i)}}
+		}
+		clone, err := f.(*tsspFile).CloneWithTombstones(ts)
+		require.NoError(t, err)
+		clones = append(clones, clone)
+		files.Append(clone)
+	}
+
+	all := files.AllTombstoneFiles()
+	require.Equal(t, 2, len(all))
+	require.Equal(t, "00000001-0000-00000000.tombstone", all["00000001-0000-00000000.tssp"][0].path)
+	require.Equal(t, "00000003-0000-00000000.tombstone", all["00000003-0000-00000000.tssp"][0].path)
+
+	// closed files are skipped
+	clones[2].Stop()
+	all = files.AllTombstoneFiles()
+	require.Equal(t, 1, len(all))
+	require.Contains(t, all, "00000001-0000-00000000.tssp")
+}
+
+func TestFileOperation_ConcurrencyLimit(t *testing.T) {
+	SetMaxConcurrentFileOperations(1)
+	defer SetMaxConcurrentFileOperations(0)
+
+	f1 := genTsspFile("00000001-0000-00000000.tssp")
+	f2 := genTsspFile("00000002-0000-00000000.tssp")
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go FileOperation(f1, func() {
+		close(started)
+		<-release
+	})
+	<-started
+
+	var ran int32
+	done := make(chan struct{})
+	go func() {
+		FileOperation(f2, func() { atomic.StoreInt32(&ran, 1) })
+		close(done)
+	}()
+
+	// the second operation is blocked until the first one returns
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(0), atomic.LoadInt32(&ran))
+
+	close(release)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("file operation is still blocked after the limit is released")
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&ran))
+	require.Equal(t, int32(1), f1.(*tsspFile).ref)
+
+	// no limit
+	SetMaxConcurrentFileOperations(0)
+	release = make(chan struct{})
+	started = make(chan struct{})
+	go FileOperation(f1, func() {
+		close(started)
+		<-release
+	})
+	<-started
+	ran = 0
+	FileOperation(f2, func() { atomic.StoreInt32(&ran, 1) })
+	require.Equal(t, int32(1), atomic.LoadInt32(&ran))
+	close(release)
+}
+
+func TestTSSPFile_TryRefAndUnrefErr(t *testing.T) {
+	// normal
+	f := genTsspFile("00000001-0000-00000000.tssp").(*tsspFile)
+	require.True(t, f.TryRef())
+	require.Equal(t, int32(2), f.ref)
+	require.NoError(t, f.UnrefErr())
+	require.Equal(t, int32(1), f.ref)
+
+	// underflow
+	require.Equal(t, errRefUnderflow, f.UnrefErr())
+	require.Panics(t, f.Unref)
+
+	// stopped
+	f = genTsspFile("00000002-0000-00000000.tssp").(*tsspFile)
+	f.Stop()
+	require.False(t, f.TryRef())
+	require.Equal(t, int32(1), f.ref)
+	require.NoError(t, f.UnrefErr())
+	require.NoError(t, f.UnrefErr())
+}
+
+func TestPromoteTempFile(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 10, 100, &idMinMax, &tmMinMax)
+	for i := 1; i <= 2; i++ {
+		fileName := NewTSSPFileName(uint64(i), 0, 0, 0, true, &lockPath)
+		msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+		for _, id := range ids {
+			require.NoError(t, msb.WriteData(id, data[id]))
+		}
+		store.AddTable(msb, true, true)
+	}
+
+	fs := store.tableFiles("mst", true)
+	require.Equal(t, 2, fs.Len())
+	defer fs.StopFiles()
+	good, bad := fs.Files()[0], fs.Files()[1]
+
+	tmpName := good.Path()
+	require.True(t, IsTempleFile(filepath.Base(tmpName)))
+	require.NoError(t, PromoteTempFile(good))
+	require.Equal(t, tmpName[:len(tmpName)-len(tmpTsspFileSuffix)], good.Path())
+	_, err := os.Stat(good.Path())
+	require.NoError(t, err)
+
+	// the file is not temporary any more
+	require.NoError(t, PromoteTempFile(good))
+
+	// a truncated file is left in place
+	tmpName = bad.Path()
+	fi, err := os.Stat(tmpName)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(tmpName, fi.Size()-10))
+	err = PromoteTempFile(bad)
+	require.True(t, errno.Equal(err, errno.TsspTrailerCorrupt))
+	require.Equal(t, tmpName, bad.Path())
+	_, err = os.Stat(tmpName)
+	require.NoError(t, err)
+}
+
+func TestVerifyFileSize(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 10, 100, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	for _, id := range ids {
+		require.NoError(t, msb.WriteData(id, data[id]))
+	}
+	store.AddTable(msb, true, false)
+	fs := store.tableFiles("mst", true)
+	defer fs.StopFiles()
+
+	name := fs.Files()[0].Path()
+	require.NoError(t, verifyFileSize(name))
+
+	buf, err := os.ReadFile(name)
+	require.NoError(t, err)
+	corrupt := filepath.Join(dir, "corrupt.tssp")
+
+	// a section is missing, but the footer is intact
+	trailOff := numberenc.UnmarshalInt64(buf[len(buf)-8:])
+	cut := append(append([]byte{}, buf[:trailOff-16]...), buf[trailOff:]...)
+	numberenc.MarshalInt64Append(cut[:len(cut)-8], trailOff-16)
+	require.NoError(t, os.WriteFile(corrupt, cut, 0600))
+	require.True(t, errno.Equal(verifyFileSize(corrupt), errno.TsspTrailerCorrupt))
+
+	// too small
+	require.NoError(t, os.WriteFile(corrupt, buf[:10], 0600))
+	require.True(t, errno.Equal(verifyFileSize(corrupt), errno.TsspTrailerCorrupt))
+
+	require.Error(t, verifyFileSize(filepath.Join(dir, "not_exists.tssp")))
+}
+
+func TestPlanCompactionReport(t *testing.T) {
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore("", &lockPath, &tier, false, NewConfig())
+	defer delete(store.Order, "mst")
+
+	var names []string
+	for i := 1; i <= 8; i++ {
+		name := filepath.Join("/data/mst", fmt.Sprintf("%08x-0000-00000000.tssp", i))
+		f := genTsspFile(name)
+		mr := f.(*tsspFile).reader.(*mockTSSPFileReader)
+		size := int64(i * 100)
+		mr.FileSizeFn = func() int64 { return size }
+		store.addTSSPFile(true, f, "mst")
+		names = append(names, name)
+	}
+	fs := store.tableFiles("mst", true)
+
+	reports := PlanCompactionReport(fs, CompactLevels)
+	require.Equal(t, []CompactPlanReport{{Files: names, ToLevel: 1, EstimateSize: 3600}}, reports)
+	for _, f := range fs.Files() {
+		require.Equal(t, int32(1), f.(*tsspFile).ref)
+	}
+	require.Empty(t, PlanCompactionReport(fs, 0))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteCompactPlanReports(buf, reports))
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Equal(t, 9, len(lines))
+	require.Equal(t, "group 0: 8 files to level 1, estimated size 3600 bytes", lines[0])
+	require.Equal(t, "\t"+names[0], lines[1])
+	require.Equal(t, "\t"+names[7], lines[8])
+}
+
+func TestFileIterators_FilterByIDRange(t *testing.T) {
+	var itrs FileIterators
+	var files []TSSPFile
+	ranges := [][2]uint64{{1, 10}, {11, 20}, {21, 30}, {31, 40}}
+	for i, r := range ranges {
+		f := genTsspFile(fmt.Sprintf("%08x-0000-00000000.tssp", i+1))
+		mr := f.(*tsspFile).reader.(*mockTSSPFileReader)
+		min, max := r[0], r[1]
+		mr.MinMaxSeriesIDFn = func() (uint64, uint64, error) { return min, max, nil }
+		mr.StatFn = func() *Trailer { return &Trailer{} }
+		mr.FileSizeFn = func() int64 { return 0 }
+		f.Ref()
+		f.RefFileReader()
+		files = append(files, f)
+		itrs = append(itrs, NewFileIterator(f, CLog))
+	}
+
+	itrs = itrs.FilterByIDRange(15, 25)
+	require.Equal(t, 2, len(itrs))
+	require.Equal(t, files[1], itrs[0].r)
+	require.Equal(t, files[2], itrs[1].r)
+
+	// the dropped iterators are closed and release their files
+	require.False(t, files[0].Inuse())
+	require.False(t, files[3].Inuse())
+	require.True(t, files[1].Inuse())
+
+	itrs = itrs.FilterByIDRange(20, 20)
+	require.Equal(t, 1, len(itrs))
+	require.Equal(t, files[1], itrs[0].r)
+	require.False(t, files[2].Inuse())
+	itrs.Close()
+	require.False(t, files[1].Inuse())
+
+	require.Empty(t, FileIterators{}.FilterByIDRange(0, 100))
+}
+
+func TestTSSPFileReader_PersistedBloomFilter(t *testing.T) {
+	testDir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(testDir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 20, 100, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(testDir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	for _, id := range ids {
+		if id%2 == 1 {
+			continue
+		}
+		require.NoError(t, msb.WriteData(id, data[id]))
+	}
+	store.AddTable(msb, true, false)
+	require.NoError(t, store.Close())
+
+	reopen := func() *tsspFileReader {
+		store = NewTableStore(testDir, &lockPath, &tier, false, conf)
+		_, err := store.Open()
+		require.NoError(t, err)
+		fs := store.tableFiles("mst", true)
+		require.Equal(t, 1, fs.Len())
+		r := fs.Files()[0].(*tsspFile).reader.(*tsspFileReader)
+		// drop the loaded components, as if the file had not been read since open
+		require.NoError(t, r.FreeFileHandle())
+		r.bloom = nil
+		r.metaIndexItems = r.metaIndexItems[:0]
+		return r
+	}
+
+	r := reopen()
+	require.NotEqual(t, int64(0), r.trailer.bloomSize)
+	rejected := 0
+	for id := uint64(2); id <= 20; id++ {
+		contains, err := r.Contains(id)
+		require.NoError(t, err)
+		if id%2 == 0 {
+			require.True(t, contains)
+		} else if !contains {
+			rejected++
+		}
+	}
+	require.NotEqual(t, 0, rejected)
+	// only the bloom filter block is loaded
+	require.NotNil(t, r.bloom)
+	require.False(t, r.initialized())
+	require.Equal(t, 0, len(r.metaIndexItems))
+	require.NoError(t, store.Close())
+
+	// files without the block fall back to the id range
+	r = reopen()
+	r.trailer.bloomSize = 0
+	for id := uint64(2); id <= 20; id++ {
+		contains, err := r.Contains(id)
+		require.NoError(t, err)
+		require.True(t, contains)
+	}
+	require.Nil(t, r.bloom)
+	require.NoError(t, store.Close())
+}
+
+func TestTSSPFile_CopyTo(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 1, 100, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	require.NoError(t, msb.WriteData(ids[0], data[ids[0]]))
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+	tf := fs.Files()[0].(*tsspFile)
+	require.NoError(t, tf.writeTombstones([]int64{100}, math.MinInt64, math.MaxInt64))
+	require.NoError(t, tf.writeTombstones([]int64{int64(ids[0])}, 0, 10))
+
+	target := filepath.Join(t.TempDir(), "cold", "mst")
+	cp, err := tf.CopyTo(target, &lockPath)
+	require.NoError(t, err)
+	defer cp.Close()
+	require.Equal(t, filepath.Join(target, filepath.Base(tf.Path())), cp.Path())
+	require.Equal(t, tf.FileSize(), cp.FileSize())
+	require.True(t, cp.IsOrder())
+
+	// the tombstone files are copied along with the data
+	srcTombstones, cpTombstones := tf.TombstoneFiles(), cp.TombstoneFiles()
+	require.Equal(t, 2, len(cpTombstones))
+	for i := range cpTombstones {
+		require.Equal(t, tombstoneFilePath(cp.Path(), i), cpTombstones[i].Path())
+		require.Equal(t, srcTombstones[i].Tombstones(), cpTombstones[i].Tombstones())
+	}
+	require.Equal(t, 2, len(tf.TombstoneFiles()))
+
+	times := data[ids[0]].Times()
+	tr := record.TimeRange{Min: times[0], Max: times[len(times)-1]}
+	fields := record.Schemas{{Name: "field1_int64", Type: influx.Field_Type_Int}, {Name: "field3_string", Type: influx.Field_Type_String}}
+	orig, copied := &record.Record{}, &record.Record{}
+	require.NoError(t, tf.ReadFields(ids[0], tr, fields, orig))
+	require.NoError(t, cp.(*tsspFile).ReadFields(ids[0], tr, fields, copied))
+	require.Equal(t, 100, orig.RowNums())
+	require.Equal(t, orig.Schema, copied.Schema)
+	for i := range orig.ColVals {
+		require.Equal(t, orig.ColVals[i].Val, copied.ColVals[i].Val)
+	}
+
+	_, err = tf.CopyTo(target, &lockPath)
+	require.Error(t, err)
+	files, err := fileops.ReadDir(target)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(files))
+}
+
+func TestOpenTSSPFileReadOnly(t *testing.T) {
+	dir := t.TempDir()
+	conf := NewConfig()
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+
+	var idMinMax, tmMinMax MinMax
+	ids, data := genMemTableData(1, 1, 100, &idMinMax, &tmMinMax)
+	fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := NewMsBuilder(dir, "mst", &lockPath, conf, 10, fileName, 0, store.Sequencer(), 2)
+	require.NoError(t, msb.WriteData(ids[0], data[ids[0]]))
+	store.AddTable(msb, true, false)
+
+	fs := store.tableFiles("mst", true)
+	require.NotEmpty(t, fs)
+	defer fs.StopFiles()
+	tf := fs.Files()[0].(*tsspFile)
+
+	f, err := OpenTSSPFileReadOnly(tf.Path(), &lockPath, true)
+	require.NoError(t, err)
+	defer f.Close()
+	require.True(t, f.IsOrder())
+
+	times := data[ids[0]].Times()
+	tr := record.TimeRange{Min: times[0], Max: times[len(times)-1]}
+	rec := &record.Record{}
+	require.NoError(t, f.(*tsspFile).ReadFields(ids[0], tr, record.Schemas{{Name: "field1_int64", Type: influx.Field_Type_Int}}, rec))
+	require.Equal(t, 100, rec.RowNums())
+
+	require.EqualError(t, f.(*tsspFile).writeTombstones([]int64{int64(ids[0])}, tr.Min, tr.Max), errFileReadOnly.Error())
+
+	_, err = OpenTSSPFileReadOnly(filepath.Join(dir, "not_exists.tssp"), &lockPath, true)
+	require.Error(t, err)
+}
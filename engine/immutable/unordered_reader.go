@@ -490,10 +490,8 @@ func NewSegmentReader(fi *FileIterator) *SegmentReader {
 }
 
 func (sr *SegmentReader) ResetContext() {
-	if sr.ctx != nil {
-		sr.ctx.Release()
-	}
-	sr.ctx = NewReadContext(true)
+	ReleaseReadContext(sr.ctx)
+	sr.ctx = AcquireReadContext()
 }
 
 func (sr *SegmentReader) Read(seg Segment, ref *record.Field, col *record.ColVal) error {
@@ -29,7 +29,7 @@ import (
 )
 
 func TestUnorderedColumnReader(t *testing.T) {
-	defer beforeTest(t, 0)
+	defer beforeTest(t, 0)()
 
 	var sid uint64 = 100
 	var begin int64 = 1e12
@@ -90,7 +90,7 @@ func TestUnorderedColumnReader(t *testing.T) {
 }
 
 func TestUnorderedColumnReader_ReadRemain(t *testing.T) {
-	defer beforeTest(t, 0)
+	defer beforeTest(t, 0)()
 	var begin int64 = 1e15
 
 	mh := NewMergeTestHelper(immutable.NewConfig())
@@ -120,7 +120,7 @@ func TestUnorderedColumnReader_ReadRemain(t *testing.T) {
 }
 
 func TestUnorderedColumnReader_error(t *testing.T) {
-	defer beforeTest(t, 0)
+	defer beforeTest(t, 0)()
 
 	var sid uint64 = 100
 	var begin int64 = 1e12
@@ -1810,6 +1810,10 @@ func (m MocTsspFile) FreeFileHandle() error {
 	return nil
 }
 
+func (m MocTsspFile) Reopen() error {
+	return nil
+}
+
 func (m MocTsspFile) Stop() {
 	return
 }
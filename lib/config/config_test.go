@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/stretchr/testify/assert"
 )
@@ -97,6 +98,16 @@ func TestMonitor(t *testing.T) {
 
 	conf.SetApp(config.AppStore)
 	assert.Equal(t, config.AppStore, conf.GetApp())
+
+	// push interval defaults to the store interval
+	assert.Equal(t, config.DefaultStoreInterval, conf.GetPushInterval())
+	conf.PushInterval = toml.Duration(time.Minute)
+	assert.Equal(t, time.Minute, conf.GetPushInterval())
+
+	conf.StoreEnabled = true
+	assert.NoError(t, conf.Validate())
+	conf.PushInterval = toml.Duration(-time.Second)
+	assert.EqualError(t, conf.Validate(), "monitor push interval must be positive")
 }
 
 func TestTSMeta(t *testing.T) {
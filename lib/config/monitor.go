@@ -154,6 +154,7 @@ type Monitor struct {
 	StoreEnabled  bool          `toml:"store-enabled"`
 	StoreDatabase string        `toml:"store-database"`
 	StoreInterval toml.Duration `toml:"store-interval"`
+	PushInterval  toml.Duration `toml:"push-interval"`
 	StorePath     string        `toml:"store-path"`
 	Compress      bool          `toml:"compress"`
 	HttpsEnabled  bool          `toml:"https-enabled"`
@@ -182,6 +183,15 @@ func (c *Monitor) GetApp() App {
 	return c.app
 }
 
+// GetPushInterval returns the period between pushing statistics,
+// it is the same as StoreInterval if PushInterval is not set
+func (c *Monitor) GetPushInterval() time.Duration {
+	if c.PushInterval != 0 {
+		return time.Duration(c.PushInterval)
+	}
+	return time.Duration(c.StoreInterval)
+}
+
 // Validate validates that the configuration is acceptable.
 func (c Monitor) Validate() error {
 	if !c.StoreEnabled {
@@ -191,6 +201,9 @@ func (c Monitor) Validate() error {
 	if c.StoreInterval <= 0 {
 		return errors.New("monitor store interval must be positive")
 	}
+	if c.PushInterval < 0 {
+		return errors.New("monitor push interval must be positive")
+	}
 	if c.StoreDatabase == "" {
 		return errors.New("monitor store database name must not be empty")
 	}
@@ -17,11 +17,13 @@ limitations under the License.
 package config
 
 import (
+	"crypto/tls"
 	"errors"
 	"time"
 
 	"github.com/influxdata/influxdb/pkg/tlsconfig"
 	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/crypto"
 	httpdConfig "github.com/openGemini/openGemini/open_src/influx/httpd/config"
 )
 
@@ -50,6 +52,12 @@ const (
 	DefaultShardTier                = "warm"
 	DefaultForceBroadcastQuery      = false
 	DefaultRetentionPolicyLimit     = 100
+
+	// DefaultShutdownTimeout is the maximum time to wait for running queries when the server is closed.
+	DefaultShutdownTimeout = 10 * time.Second
+
+	// DefaultMetaConnectRetries is the number of retries to connect to the meta servers on startup.
+	DefaultMetaConnectRetries = 10
 )
 
 // TSSql represents the configuration format for the TSSql binary.
@@ -66,6 +74,8 @@ type TSSql struct {
 	TLS      tlsconfig.Config `toml:"tls"`
 	Analysis Castor           `toml:"castor"`
 	Sherlock *SherlockConfig  `toml:"sherlock"`
+
+	ListenerTLS ListenerTLS `toml:"listener-tls"`
 }
 
 // NewTSSql returns an instance of Config with reasonable defaults.
@@ -87,6 +97,7 @@ func (c *TSSql) Validate() error {
 		c.Common,
 		c.Monitor,
 		c.TLS,
+		c.ListenerTLS,
 		c.Logging,
 		c.Coordinator,
 		c.HTTP,
@@ -129,6 +140,8 @@ type Coordinator struct {
 	LogQueriesAfter      toml.Duration `toml:"log-queries-after"`
 	ShardWriterTimeout   toml.Duration `toml:"shard-writer-timeout"`
 	ShardMapperTimeout   toml.Duration `toml:"shard-mapper-timeout"`
+	ShutdownTimeout      toml.Duration `toml:"shutdown-timeout"`
+	MetaConnectRetries   int           `toml:"meta-connect-retries"`
 	// Maximum number of memory bytes to use from the query
 	MaxQueryMem              toml.Size       `toml:"max-query-mem"`
 	MetaExecutorWriteTimeout toml.Duration   `toml:"meta-executor-write-timeout"`
@@ -151,6 +164,8 @@ func NewCoordinator() Coordinator {
 		MaxConcurrentQueries:     DefaultMaxConcurrentQueries,
 		ShardWriterTimeout:       toml.Duration(DefaultShardWriterTimeout),
 		ShardMapperTimeout:       toml.Duration(DefaultShardMapperTimeout),
+		ShutdownTimeout:          toml.Duration(DefaultShutdownTimeout),
+		MetaConnectRetries:       DefaultMetaConnectRetries,
 		MaxQueryMem:              toml.Size(DefaultMaxQueryMem),
 		QueryTimeCompareEnabled:  true,
 		MetaExecutorWriteTimeout: toml.Duration(DefaultMetaExecutorWriteTimeout),
@@ -174,8 +189,62 @@ func (c Coordinator) Validate() error {
 	if c.ShardMapperTimeout < 0 {
 		return errors.New("coordinator shard-mapper-timeout can not be negative")
 	}
+	if c.ShutdownTimeout < 0 {
+		return errors.New("coordinator shutdown-timeout can not be negative")
+	}
+	if c.MetaConnectRetries < 0 {
+		return errors.New("coordinator meta-connect-retries can not be negative")
+	}
 	if c.RetentionPolicyLimit <= 0 {
 		return errors.New("coordinator rp-limit can not be negative")
 	}
 	return nil
 }
+
+// ListenerTLS represents the certificate of the TLS listener of the server,
+// the listener serves in plaintext if the certificate is not set.
+// The min version and the cipher suites are configured in the tls section.
+type ListenerTLS struct {
+	Certificate string `toml:"certificate"`
+	PrivateKey  string `toml:"private-key"`
+}
+
+func (c ListenerTLS) Enabled() bool {
+	return c.Certificate != ""
+}
+
+// GetPrivateKey returns the private key file, the certificate file is
+// used if it is not set, like https-private-key does
+func (c ListenerTLS) GetPrivateKey() string {
+	if c.PrivateKey == "" {
+		return c.Certificate
+	}
+	return c.PrivateKey
+}
+
+// Validate validates that the configuration is acceptable.
+func (c ListenerTLS) Validate() error {
+	if !c.Enabled() {
+		if c.PrivateKey != "" {
+			return errors.New("listener-tls private-key is set without certificate")
+		}
+		return nil
+	}
+
+	return NewCertValidator(c.Certificate, c.GetPrivateKey()).Validate()
+}
+
+// NewTLSConfig returns a copy of base with the certificate of the listener
+func (c ListenerTLS) NewTLSConfig(base *tls.Config) (*tls.Config, error) {
+	cert, err := tls.X509KeyPair([]byte(crypto.DecryptFromFile(c.Certificate)), []byte(crypto.DecryptFromFile(c.GetPrivateKey())))
+	if err != nil {
+		return nil, err
+	}
+
+	conf := new(tls.Config)
+	if base != nil {
+		conf = base.Clone()
+	}
+	conf.Certificates = []tls.Certificate{cert}
+	return conf, nil
+}
@@ -203,8 +203,11 @@ type Store struct {
 	QuerySeriesLimit  int           `toml:"query-series-limit"`
 
 	ReadCacheLimit       toml.Size `toml:"read-cache-limit"`
+	BlockCacheLimit      toml.Size `toml:"block-cache-limit"` // 0 disables the block cache shared by tssp files
 	WriteConcurrentLimit int       `toml:"write-concurrent-limit"`
 	OpenShardLimit       int       `toml:"open-shard-limit"`
+	// maximum number of tssp files referenced by file operations at the same time, 0 means unlimited
+	MaxConcurrentFileOperations int `toml:"max-concurrent-file-operations"`
 
 	DownSampleWriteDrop bool `toml:"downsample-write-drop"`
 
@@ -309,6 +312,7 @@ func (c Store) Validate() error {
 	ivItems := []intValidatorItem{
 		{"data max-concurrent-compactions", int64(c.MaxConcurrentCompactions), true},
 		{"data max-full-compactions", int64(c.MaxFullCompactions), true},
+		{"data max-concurrent-file-operations", int64(c.MaxConcurrentFileOperations), true},
 		{"data imm-table-max-memory-percentage", int64(c.ImmTableMaxMemoryPercentage), false},
 		{"data write-cold-duration", int64(c.WriteColdDuration), false},
 		{"data max-write-hang-time", int64(c.MaxWriteHangTime), false},
@@ -133,6 +133,11 @@ const (
 	IndexNotFound                      = 2131
 	FailedToDecodeFloatArray           = 2132
 	InvalidFloatBuffer                 = 2133
+	InvalidTsspFileName                = 2134
+	TsspReaderOpenFailed               = 2135
+	TsspTrailerCorrupt                 = 2136
+	TsspFileVersionIncompatible        = 2137
+	TsspFileNotExist                   = 2138
 )
 
 // merge out of order
@@ -78,6 +78,7 @@ type Error struct {
 	level  Level
 	stack  []byte
 	module Module
+	cause  error
 }
 
 func (s *Error) Error() string {
@@ -100,6 +101,16 @@ func (s *Error) Stack() []byte {
 	return s.stack
 }
 
+// Unwrap returns the error set by SetCause, so that errors.Is and errors.As can match it
+func (s *Error) Unwrap() error {
+	return s.cause
+}
+
+func (s *Error) SetCause(err error) *Error {
+	s.cause = err
+	return s
+}
+
 func (s *Error) SetModule(module Module) *Error {
 	s.module = module
 	return s
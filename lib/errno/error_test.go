@@ -261,3 +261,14 @@ func BenchmarkErrsPool(b *testing.B) {
 		errno.NewErrsPool().Put(errs)
 	}
 }
+
+func TestErrorCause(t *testing.T) {
+	cause := errors.New("cause")
+	err := errno.NewError(errno.TsspReaderOpenFailed, "a.tssp", cause)
+	assert.False(t, errors.Is(err, cause))
+
+	err.SetCause(cause)
+	assert.True(t, errors.Is(err, cause))
+	assert.EqualError(t, err, "open tssp file reader failed: a.tssp, err: cause")
+	assert.True(t, errors.Is(fmt.Errorf("wrap: %w", err), cause))
+}
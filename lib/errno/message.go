@@ -157,6 +157,11 @@ var messageMap = map[Errno]*Message{
 	IndexNotFound:                      newWarnMessage("shard index not exist db %s ,pt %v ,index %v", ModuleTssp),
 	FailedToDecodeFloatArray:           newFatalMessage("failed to decode float array. exp length: %d, got: %d", ModuleStorageEngine),
 	InvalidFloatBuffer:                 newFatalMessage("invalid input float encoded data, type = %v", ModuleStorageEngine),
+	InvalidTsspFileName:                newWarnMessage("invalid tssp file name: %s, err: %v", ModuleTssp),
+	TsspReaderOpenFailed:               newFatalMessage("open tssp file reader failed: %s, err: %v", ModuleTssp),
+	TsspTrailerCorrupt:                 newFatalMessage("tssp file trailer is corrupt: %s, err: %v", ModuleTssp),
+	TsspFileVersionIncompatible:        newWarnMessage("tssp file version is incompatible: %s, %s", ModuleTssp),
+	TsspFileNotExist:                   newWarnMessage("tssp file does not exist: %s", ModuleTssp),
 
 	// wal error codes
 	ReadWalFileFailed:         newWarnMessage("read wal file failed", ModuleWal),
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/lib/config"
@@ -36,7 +37,9 @@ var hooks []*lumberjack.Logger
 
 var initHandler func(*zap.Logger)
 
-var level zapcore.Level
+var level int32 // zapcore.Level, accessed atomically since SetLevel may be called at runtime
+
+var atomicLevel = zap.NewAtomicLevel()
 
 func init() {
 	InitLogger(config.NewLogger(config.AppSingle))
@@ -47,7 +50,7 @@ func SetInitLoggerHandler(handler func(*zap.Logger)) {
 }
 
 func InitLogger(conf config.Logger) {
-	level = conf.Level
+	atomic.StoreInt32(&level, int32(conf.Level))
 	logger = getLogger(conf)
 	if initHandler != nil {
 		initHandler(logger)
@@ -67,6 +70,16 @@ func SetLogger(zapLogger *zap.Logger) {
 	}
 }
 
+// SetLevel changes the level of the logs emitted at runtime
+func SetLevel(l zapcore.Level) {
+	atomic.StoreInt32(&level, int32(l))
+	atomicLevel.SetLevel(rewriteLevel(l))
+}
+
+func GetLevel() zapcore.Level {
+	return zapcore.Level(atomic.LoadInt32(&level))
+}
+
 func CloseLogger() {
 	_ = logger.Sync()
 	closeHooks()
@@ -74,7 +87,7 @@ func CloseLogger() {
 
 func getLogger(conf config.Logger) *zap.Logger {
 	maxSize := rewriteMaxSize(conf.MaxSize)
-	level := rewriteLevel(conf.Level)
+	atomicLevel.SetLevel(rewriteLevel(conf.Level))
 	hookNormal := newHook(conf, maxSize, conf.GetFileName())
 	hookError := newHook(conf, maxSize, makeErrFileName(conf.GetFileName()))
 	hooks = append(hooks, hookNormal, hookError)
@@ -82,7 +95,7 @@ func getLogger(conf config.Logger) *zap.Logger {
 	encoder := newEncoder()
 
 	levelNormal := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-		return lvl >= level
+		return atomicLevel.Enabled(lvl)
 	})
 	levelError := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
 		return lvl >= zapcore.ErrorLevel
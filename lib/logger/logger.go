@@ -132,7 +132,8 @@ func (ls *LogSuppressor) getCallerFrame(skip int) (frame runtime.Frame, ok bool)
 }
 
 type SuppressLogger struct {
-	logger        *zap.Logger
+	logger        *zap.Logger // replaced by SetZapLogger while logging, read it through zapLogger
+	loggerMu      sync.RWMutex
 	logSuppressor *LogSuppressor
 
 	timer   *time.Timer
@@ -237,12 +238,12 @@ func (l *SuppressLogger) defaultNotify(log *SuppressLog) {
 func (l *SuppressLogger) Close() {
 	l.closeOnce.Do(func() {
 		close(l.closed)
-		_ = l.logger.Sync()
+		_ = l.zapLogger().Sync()
 	})
 }
 
 func (l *SuppressLogger) With(fields ...zap.Field) *SuppressLogger {
-	l.logger.With(fields...)
+	l.zapLogger().With(fields...)
 	return l
 }
 
@@ -257,7 +258,7 @@ func (l *SuppressLogger) Error(n errno.Node, m errno.Module, msg string, fields
 func (l *SuppressLogger) error(log *SuppressLog) {
 	fields := l.rewriteFields(log.Fields, log.Node, log.Module)
 	fields = l.addSuppressField(fields, log)
-	l.logger.Error(log.Message, fields...)
+	l.zapLogger().Error(log.Message, fields...)
 }
 
 func (l *SuppressLogger) Info(msg string, fields ...zap.Field) {
@@ -270,7 +271,7 @@ func (l *SuppressLogger) Info(msg string, fields ...zap.Field) {
 
 func (l *SuppressLogger) info(log *SuppressLog) {
 	fields := l.addSuppressField(log.Fields, log)
-	l.logger.Info(log.Message, fields...)
+	l.zapLogger().Info(log.Message, fields...)
 }
 
 func (l *SuppressLogger) Warn(msg string, fields ...zap.Field) {
@@ -283,7 +284,7 @@ func (l *SuppressLogger) Warn(msg string, fields ...zap.Field) {
 
 func (l *SuppressLogger) warn(log *SuppressLog) {
 	fields := l.addSuppressField(log.Fields, log)
-	l.logger.Warn(log.Message, fields...)
+	l.zapLogger().Warn(log.Message, fields...)
 }
 
 func (l *SuppressLogger) Debug(msg string, fields ...zap.Field) {
@@ -296,33 +297,42 @@ func (l *SuppressLogger) Debug(msg string, fields ...zap.Field) {
 
 func (l *SuppressLogger) debug(log *SuppressLog) {
 	fields := l.addSuppressField(log.Fields, log)
-	l.logger.Debug(log.Message, fields...)
+	l.zapLogger().Debug(log.Message, fields...)
 }
 
 func (l *SuppressLogger) dpanic(log *SuppressLog) {
 	fields := l.addSuppressField(log.Fields, log)
-	l.logger.DPanic(log.Message, fields...)
+	l.zapLogger().DPanic(log.Message, fields...)
 }
 
 func (l *SuppressLogger) panic(log *SuppressLog) {
 	fields := l.addSuppressField(log.Fields, log)
-	l.logger.Panic(log.Message, fields...)
+	l.zapLogger().Panic(log.Message, fields...)
 }
 
 func (l *SuppressLogger) fatal(log *SuppressLog) {
 	fields := l.addSuppressField(log.Fields, log)
-	l.logger.Fatal(log.Message, fields...)
+	l.zapLogger().Fatal(log.Message, fields...)
 }
 
 func (l *SuppressLogger) GetZapLogger() *zap.Logger {
-	return l.logger.WithOptions(zap.WithCaller(true))
+	return l.zapLogger().WithOptions(zap.WithCaller(true))
 }
 
 func (l *SuppressLogger) SetZapLogger(lg *zap.Logger) *SuppressLogger {
-	l.logger = lg.WithOptions(zap.WithCaller(false))
+	lg = lg.WithOptions(zap.WithCaller(false))
+	l.loggerMu.Lock()
+	l.logger = lg
+	l.loggerMu.Unlock()
 	return l
 }
 
+func (l *SuppressLogger) zapLogger() *zap.Logger {
+	l.loggerMu.RLock()
+	defer l.loggerMu.RUnlock()
+	return l.logger
+}
+
 func (l *SuppressLogger) addSuppressField(fields []zapcore.Field, log *SuppressLog) []zap.Field {
 	//add location field
 	entries := strings.Split(log.Frame.File, "/")
@@ -437,7 +447,7 @@ func (l *Logger) Warn(msg string, fields ...zap.Field) {
 }
 
 func (l *Logger) Debug(msg string, fields ...zap.Field) {
-	if level > zapcore.DebugLevel {
+	if GetLevel() > zapcore.DebugLevel {
 		return
 	}
 	l.logger.Debug(msg, fields...)
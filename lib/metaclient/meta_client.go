@@ -280,6 +280,8 @@ type Client struct {
 	closing     chan struct{}
 	changed     chan chan struct{}
 	cacheData   *meta2.Data
+	// snapshotLoaded is set once Open has loaded the first snapshot from the meta servers
+	snapshotLoaded bool
 
 	// Authentication cache.
 	authCache map[string]authUser
@@ -337,7 +339,11 @@ func NewClient(weakPwdPath string, retentionAutoCreate bool, maxConcurrentWriteL
 
 // Open a connection to a meta service cluster.
 func (c *Client) Open() error {
-	c.cacheData = c.retryUntilSnapshot(SQL, 0)
+	data := c.retryUntilSnapshot(SQL, 0)
+	c.mu.Lock()
+	c.cacheData = data
+	c.snapshotLoaded = data != nil
+	c.mu.Unlock()
 	go c.pollForUpdates(SQL)
 
 	go c.updateAuthCacheData()
@@ -369,6 +375,14 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// SnapshotLoaded returns true once the first meta snapshot has been loaded by Open,
+// it stays false if the client is closed before that
+func (c *Client) SnapshotLoaded() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.snapshotLoaded
+}
+
 // NodeID GetNodeID returns the client's node ID.
 func (c *Client) NodeID() uint64 { return c.nodeID }
 
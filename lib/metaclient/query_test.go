@@ -106,7 +106,7 @@ func MockMetaData() (meta.Data, error) {
 				FieldName: proto.String("year"),
 				FieldType: proto.Int32(influx.Field_Type_Tag),
 			},
-		})
+		}, 0)
 	})
 
 	err := exec.Execute()
@@ -65,12 +65,15 @@ type EngineOptions struct {
 
 	// Immutable config
 	ReadCacheLimit   uint64
+	BlockCacheLimit  uint64
 	CacheDataBlock   bool
 	CacheMetaBlock   bool
 	EnableMmapRead   bool
 	CompactionMethod int // 0:auto, 1:stream, 2: non-stream
 	OpenShardLimit   int
 
+	MaxConcurrentFileOperations int
+
 	DownSampleWriteDrop          bool
 	MaxDownSampleTaskConcurrency int
 }
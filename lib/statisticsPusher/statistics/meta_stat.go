@@ -51,6 +51,8 @@ type MetaStatCollector struct {
 	items map[string]StatItem
 	mu    sync.RWMutex
 	stop  chan struct{}
+
+	stopOnce sync.Once
 }
 
 func (c *MetaStatCollector) Push(item StatItem) {
@@ -113,7 +115,9 @@ func (c *MetaStatCollector) Items() map[string]StatItem {
 }
 
 func (c *MetaStatCollector) Stop() {
-	close(c.stop)
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
 }
 
 type MetadataStatistics struct {
@@ -52,14 +52,27 @@ type StatisticsPusher struct {
 var bufferPool = bufferpool.NewByteBufferPool(0)
 var sp *StatisticsPusher
 var once sync.Once
+var spMu sync.Mutex // guards sp, which ReloadStatisticsPusher replaces at runtime
 
 func NewStatisticsPusher(conf *config.Monitor, logger *logger.Logger) *StatisticsPusher {
+	spMu.Lock()
+	defer spMu.Unlock()
 	once.Do(func() {
 		sp = newStatisticsPusher(conf, logger)
 	})
 	return sp
 }
 
+// ReloadStatisticsPusher replaces the statistics pusher returned by NewStatisticsPusher
+// with a new one created with conf, the previous one should be stopped by the caller
+func ReloadStatisticsPusher(conf *config.Monitor, logger *logger.Logger) *StatisticsPusher {
+	spMu.Lock()
+	defer spMu.Unlock()
+	once.Do(func() {})
+	sp = newStatisticsPusher(conf, logger)
+	return sp
+}
+
 func newStatisticsPusher(conf *config.Monitor, logger *logger.Logger) *StatisticsPusher {
 	var pushers []pusher.Pusher
 	for _, pt := range strings.Split(conf.Pushers, config.PusherSep) {
@@ -78,14 +91,14 @@ func newStatisticsPusher(conf *config.Monitor, logger *logger.Logger) *Statistic
 		return nil
 	}
 
-	statistics.NewTimestamp().Init(time.Duration(conf.StoreInterval))
+	statistics.NewTimestamp().Init(conf.GetPushInterval())
 	return &StatisticsPusher{
 		pushers:      pushers,
 		stopping:     make(chan struct{}),
 		collects:     make(map[uintptr]collectFunc),
 		opsCollects:  make(map[uintptr]opsCollectFunc),
 		logger:       logger,
-		pushInterval: time.Duration(conf.StoreInterval),
+		pushInterval: conf.GetPushInterval(),
 	}
 }
 
@@ -165,6 +178,10 @@ func (sp *StatisticsPusher) RegisterOps(collects ...opsCollectFunc) {
 	}
 }
 
+func (sp *StatisticsPusher) PushInterval() time.Duration {
+	return sp.pushInterval
+}
+
 // Start starts push statistics data in interval time
 func (sp *StatisticsPusher) Start() {
 	sp.startOnce.Do(sp.start)
@@ -17,7 +17,9 @@ limitations under the License.
 package statisticsPusher
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/lib/config"
@@ -48,3 +50,32 @@ func TestPusher(t *testing.T) {
 		t.Fatalf("exp %d pushers, got: %d", 2, len(sp.pushers))
 	}
 }
+
+func TestPusherInterval(t *testing.T) {
+	conf := &config.Monitor{
+		StoreInterval: toml.Duration(config.DefaultStoreInterval),
+		PushInterval:  toml.Duration(20 * time.Millisecond),
+		Pushers:       config.FilePusher,
+		StorePath:     t.TempDir() + "/stat_metric.data",
+	}
+
+	sp := newStatisticsPusher(conf, logger.NewLogger(errno.ModuleUnknown))
+	if sp.PushInterval() != 20*time.Millisecond {
+		t.Fatalf("exp push interval %v, got: %v", 20*time.Millisecond, sp.PushInterval())
+	}
+
+	var pushed int64
+	sp.Register(func(buf []byte) ([]byte, error) {
+		atomic.AddInt64(&pushed, 1)
+		return buf, nil
+	})
+	sp.Start()
+	time.Sleep(210 * time.Millisecond)
+	close(sp.stopping)
+	sp.wg.Wait()
+
+	// 10 ticks, allow some jitter of the ticker
+	if n := atomic.LoadInt64(&pushed); n < 5 || n > 11 {
+		t.Fatalf("exp about %d pushes, got: %d", 10, n)
+	}
+}
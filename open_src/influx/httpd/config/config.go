@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/influxdata/influxdb/toml"
@@ -33,6 +34,9 @@ const (
 	DefaultMaxRowNum = 1000000
 
 	DefaultBlockSize = 64 * 1024
+
+	// DefaultStatsPath is the default path of the runtime statistics endpoint.
+	DefaultStatsPath = "/debug/stats"
 )
 
 // Config represents a configuration for a HTTP service.
@@ -76,6 +80,8 @@ type Config struct {
 	QueryMemoryLimitEnabled bool           `toml:"query-memory-limit-enabled"`
 	ChunkReaderParallel     int            `toml:"chunk-reader-parallel"`
 	ReadBlockSize           toml.Size      `toml:"read-block-size"`
+	StatsEnabled            bool           `toml:"stats-enabled"`
+	StatsPath               string         `toml:"stats-path"`
 }
 
 // NewHttpConfig returns a new Config with default settings.
@@ -102,6 +108,8 @@ func NewConfig() Config {
 		QueryMemoryLimitEnabled: true,
 		ChunkReaderParallel:     cpu.GetCpuNum(),
 		ReadBlockSize:           toml.Size(DefaultBlockSize),
+		StatsEnabled:            false,
+		StatsPath:               DefaultStatsPath,
 	}
 }
 
@@ -128,6 +136,9 @@ func (c Config) Validate() error {
 	if c.ChunkReaderParallel < 0 {
 		return errors.New("http chunk-reader-parallel can not be negative")
 	}
+	if c.StatsEnabled && !strings.HasPrefix(c.StatsPath, "/") {
+		return errors.New("http stats-path must start with /")
+	}
 	if c.MaxBodySize < 0 {
 		return errors.New("http max-body-size can not be negative")
 	}
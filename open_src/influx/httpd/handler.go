@@ -151,11 +151,11 @@ type Handler struct {
 	accessLog        *os.File
 	accessLogFilters config.StatusFilters
 
-	requestTracker   *httpd.RequestTracker
-	writeThrottler   *Throttler
-	queryThrottler   *Throttler
-	slowQueries      chan *hybridqp.SelectDuration
-	StatisticsPusher *statisticsPusher.StatisticsPusher
+	requestTracker *httpd.RequestTracker
+	writeThrottler *Throttler
+	queryThrottler *Throttler
+	slowQueries    chan *hybridqp.SelectDuration
+	statsPusher    atomic.Value // *statisticsPusher.StatisticsPusher, replaced when the monitor settings are reloaded
 }
 
 // NewHandler returns a new instance of handler with routes.
@@ -1432,9 +1432,20 @@ func (h *Handler) serveFluxQuery(w http.ResponseWriter, r *http.Request, user me
 	h.httpError(w, "not implementation", http.StatusBadRequest)
 }
 
+// SetStatisticsPusher sets the pusher whose statistics are served by /debug/vars, it may be called while serving
+func (h *Handler) SetStatisticsPusher(sp *statisticsPusher.StatisticsPusher) {
+	h.statsPusher.Store(sp)
+}
+
+// StatisticsPusher returns the pusher set by SetStatisticsPusher, nil if none is set
+func (h *Handler) StatisticsPusher() *statisticsPusher.StatisticsPusher {
+	sp, _ := h.statsPusher.Load().(*statisticsPusher.StatisticsPusher)
+	return sp
+}
+
 // serveExpvar serves internal metrics in /debug/vars format over HTTP.
 func (h *Handler) serveExpvar(w http.ResponseWriter, r *http.Request) {
-	app.SetStatsResponse(h.StatisticsPusher, w, r)
+	app.SetStatsResponse(h.StatisticsPusher(), w, r)
 }
 
 // serveDebugRequests will track requests for a period of time.
@@ -31,6 +31,9 @@ type Service struct {
 	tlsConfig *tls.Config
 	err       chan error
 
+	// listenerTLS serves the tcp listeners over TLS if https is not enabled
+	listenerTLS *tls.Config
+
 	unixSocket         bool
 	unixSocketPerm     uint32
 	unixSocketGroup    int
@@ -88,6 +91,13 @@ func (s *Service) Openlistener(addr string) error {
 			return err
 		}
 
+		s.Ln = append(s.Ln, listener)
+	} else if s.listenerTLS != nil {
+		listener, err := tls.Listen("tcp", addr, s.listenerTLS)
+		if err != nil {
+			return err
+		}
+
 		s.Ln = append(s.Ln, listener)
 	} else {
 		listener, err := net.Listen("tcp", addr)
@@ -99,10 +109,16 @@ func (s *Service) Openlistener(addr string) error {
 	}
 	s.Logger.Info("Listening on HTTP",
 		zap.Stringer("addr", s.Ln[len(s.Ln)-1].Addr()),
-		zap.Bool("https", s.https))
+		zap.Bool("https", s.https || s.listenerTLS != nil))
 	return nil
 }
 
+// SetListenerTLS sets the tls config, including the certificate, of the tcp listeners opened afterwards.
+// The https certificate takes precedence if https is enabled
+func (s *Service) SetListenerTLS(conf *tls.Config) {
+	s.listenerTLS = conf
+}
+
 // Open starts the service.
 func (s *Service) Open() error {
 	s.Logger.Info("Starting HTTP service", zap.Bool("authentication", s.Handler.Config.AuthEnabled))
@@ -209,6 +225,25 @@ func (s *Service) Close() error {
 	return nil
 }
 
+// CloseListeners stops accepting new connections, the connections already accepted are kept.
+func (s *Service) CloseListeners() error {
+	for i, ln := range s.Ln {
+		if ln != nil {
+			if err := ln.Close(); err != nil {
+				return err
+			}
+			s.Ln[i] = nil
+		}
+	}
+	if s.unixSocketListener != nil {
+		if err := s.unixSocketListener.Close(); err != nil {
+			return err
+		}
+		s.unixSocketListener = nil
+	}
+	return nil
+}
+
 // Err returns a channel for fatal errors that occur on the listener.
 func (s *Service) Err() <-chan error { return s.err }
 
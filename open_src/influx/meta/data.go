@@ -285,13 +285,7 @@ func (data *Data) UpdateSchemaBitmap(database string, retentionPolicy string, ms
 		_, ok := schemaBitmap[id]
 		if !ok {
 			schemaBitmap[id] = mst
-			for key, info := range msti.Schema {
-				if info.ID == id {
-					info.Ref++
-					msti.Schema[key] = info
-					break
-				}
-			}
+			msti.RefKey(id)
 		}
 	}
 	indexGroupInfo.SchemaBitmap = schemaBitmap
@@ -299,7 +293,9 @@ func (data *Data) UpdateSchemaBitmap(database string, retentionPolicy string, ms
 
 }
 
-func (data *Data) UpdateSchema(database string, retentionPolicy string, mst string, fieldToCreate []*proto2.FieldSchema) error {
+// UpdateSchema creates the fields missing in the measurement, updatedAt is the time stamped
+// by the leader in the command, the update time of the measurement is left unchanged if it is 0
+func (data *Data) UpdateSchema(database string, retentionPolicy string, mst string, fieldToCreate []*proto2.FieldSchema, updatedAt int64) error {
 	msti, err := data.Measurement(database, retentionPolicy, mst)
 	if err != nil {
 		return err
@@ -309,28 +305,7 @@ func (data *Data) UpdateSchema(database string, retentionPolicy string, mst stri
 		return err
 	}
 
-	schema := make(map[string]KeyInfo)
-	for field := range msti.Schema {
-		schema[field] = msti.Schema[field]
-	}
-
-	for i := range fieldToCreate {
-		existKeyInfo, ok := schema[fieldToCreate[i].GetFieldName()]
-		if !ok {
-			ki := KeyInfo{}
-			ki.ID = rp.getKeyID()
-			ki.Ref = 0
-			ki.Type = fieldToCreate[i].GetFieldType()
-			schema[fieldToCreate[i].GetFieldName()] = ki
-			continue
-		}
-		if existKeyInfo.Type != fieldToCreate[i].GetFieldType() {
-			return ErrFieldTypeConflict
-		}
-	}
-
-	msti.Schema = schema
-	return nil
+	return msti.CreateFields(fieldToCreate, rp.getKeyID, updatedAt)
 }
 
 func (data *Data) ReSharding(info *ReShardingInfo) error {
@@ -433,7 +408,7 @@ func (data *Data) createVersionMeasurement(rp *RetentionPolicyInfo, shardKey *pr
 	}
 	nameWithVer := influx.GetNameWithVersion(mst, version)
 
-	msti := &MeasurementInfo{Name: nameWithVer, originName: mst}
+	msti := NewMeasurementInfo(nameWithVer)
 	if shardKey != nil {
 		msti.ShardKeys = []ShardKeyInfo{*ski}
 	}
@@ -1220,7 +1195,7 @@ func (data *Data) MarkMeasurementDelete(database, policy, measurement string) er
 	if err = data.checkMigrateConflict(database); err != nil {
 		return err
 	}
-	mst.MarkDeleted = true
+	mst.SetMarkDeleted(true)
 	return nil
 }
 
@@ -1794,17 +1769,7 @@ func (data *Data) pruneIndexGroups(id uint64) error {
 						if msti == nil {
 							continue
 						}
-						for keyName, keyInfo := range msti.Schema {
-							if keyInfo.ID == keyID {
-								keyInfo.Ref--
-								if keyInfo.Ref <= 0 {
-									delete(msti.Schema, keyName)
-								} else {
-									msti.Schema[keyName] = keyInfo
-								}
-								break
-							}
-						}
+						msti.UnrefKey(keyID)
 					}
 
 				} else {
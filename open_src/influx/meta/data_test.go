@@ -89,6 +89,13 @@ func Test_Data_CreateMeasurement(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// the measurement has its own schema lock and tag keys cache
+	msti, err := data.Measurement(dbName, rpName, mstName)
+	require.NoError(t, err)
+	require.NotNil(t, msti.schemaLock)
+	require.NotNil(t, msti.tagKeysCache)
+	require.Equal(t, mstName, msti.OriginName())
+
 	// try to recreate measurement with same shardKey, should success
 	err = data.CreateMeasurement(dbName, rpName, mstName,
 		&proto2.ShardKeyInfo{ShardKey: []string{"hostName", "location"}, Type: proto.String(influxql.RANGE)}, nil)
@@ -1018,7 +1025,7 @@ func BenchmarkData_UpdateSchema(b *testing.B) {
 	b.ReportAllocs()
 
 	for i := 0; i < b.N; i++ {
-		data.UpdateSchema(databases[i%n], rpName, mstName, schemas[i%n])
+		data.UpdateSchema(databases[i%n], rpName, mstName, schemas[i%n], 0)
 	}
 	b.StopTimer()
 }
@@ -1211,3 +1218,26 @@ func TestUpdateShardInfo(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestData_UpdateSchemaUpdatedAt(t *testing.T) {
+	data := initData()
+	require.NoError(t, generateMeasurement(data, "foo", "bar", "cpu"))
+	fields := []*proto2.FieldSchema{{FieldName: proto.String("f1"), FieldType: proto.Int32(influx.Field_Type_Float)}}
+
+	// the time stamped in the command is recorded, so that every node applies the same
+	require.NoError(t, data.UpdateSchema("foo", "bar", "cpu", fields, 100))
+	msti, err := data.Measurement("foo", "bar", "cpu")
+	require.NoError(t, err)
+	require.Equal(t, int64(100), msti.UpdatedAt)
+	require.Contains(t, msti.Schema, "f1")
+
+	// nothing is created
+	require.NoError(t, data.UpdateSchema("foo", "bar", "cpu", fields, 200))
+	require.Equal(t, int64(100), msti.UpdatedAt)
+
+	// a command without the time
+	fields = append(fields, &proto2.FieldSchema{FieldName: proto.String("f2"), FieldType: proto.Int32(influx.Field_Type_Int)})
+	require.NoError(t, data.UpdateSchema("foo", "bar", "cpu", fields, 0))
+	require.Equal(t, int64(100), msti.UpdatedAt)
+	require.Contains(t, msti.Schema, "f2")
+}
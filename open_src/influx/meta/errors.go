@@ -201,6 +201,24 @@ var (
 
 	ErrFieldTypeConflict = errors.New("field type conflict")
 
+	ErrFieldNotFound = errors.New("field not found")
+
+	ErrFieldExists = errors.New("field already exists")
+
+	ErrDropTimeField = errors.New("time field can not be dropped")
+
+	ErrDropShardKey = errors.New("shard key can not be dropped")
+
+	ErrIndexEmpty = errors.New("index would be left without any column")
+
+	ErrIndexExists = errors.New("index already exists")
+
+	ErrIndexRelationInconsistent = errors.New("oids, index names and index lists of index relation are not aligned")
+
+	ErrMeasurementRemoved = errors.New("measurement has been physically removed")
+
+	ErrDownSamplePolicyExists = errors.New("downsample policy already exists")
+
 	ErrUnsupportCommand = errors.New("unsupported command")
 
 	ErrCommandTimeout = errors.New("execute command timeout")
@@ -17,7 +17,14 @@ limitations under the License.
 package meta
 
 import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/gogo/protobuf/proto"
+	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/open_src/influx/influxql"
 	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
 	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
@@ -50,41 +57,566 @@ type MeasurementInfo struct {
 	originName string // cache original measurement name
 	ShardKeys  []ShardKeyInfo
 	// Schema     map[string]int32
-	Schema        map[string]KeyInfo // tags/fields
+	// Schema holds the tags/fields, it is guarded by the schema lock:
+	// mutate it only through AddField, AddTag, RenameField, DropField, DropFieldAndIndex, CreateFields, RefKey and UnrefKey,
+	// the methods reading it take the read lock, hold RLockSchema when reading it directly
+	Schema        map[string]KeyInfo
 	IndexRelation IndexRelation
 	MarkDeleted   bool
+	TTL           int64 // retention of the measurement in nanoseconds, 0 means inheriting from the retention policy
+
+	EstimatedCardinality uint64            // rough series cardinality, used by the planner
+	UpdatedAt            int64             // unix nanoseconds of the last schema change
+	RetentionOverride    *int64            // retention of the measurement in nanoseconds, nil means inheriting
+	Aliases              map[string]string // alias -> canonical field name, for names used before migration
+	DownSamplePolicies   []MeasurementDownSamplePolicy
+
+	schemaVersion uint64 // bumped whenever the keys of Schema change
+	tagKeysCache  *tagKeysCache
+	schemaLock    *sync.RWMutex
 }
 
+// fallbackSchemaLock guards the Schema of measurements not created by NewMeasurementInfo or unmarshal
+var fallbackSchemaLock sync.RWMutex
+
 func NewMeasurementInfo(nameWithVer string) *MeasurementInfo {
 	return &MeasurementInfo{
-		Name:       nameWithVer,
-		originName: influx.GetOriginMstName(nameWithVer),
+		Name:         nameWithVer,
+		originName:   influx.GetOriginMstName(nameWithVer),
+		tagKeysCache: newTagKeysCache(),
+		schemaLock:   &sync.RWMutex{},
+	}
+}
+
+func (msti *MeasurementInfo) lockOfSchema() *sync.RWMutex {
+	if msti.schemaLock == nil {
+		return &fallbackSchemaLock
+	}
+	return msti.schemaLock
+}
+
+// RLockSchema locks Schema for reading, the mutators of Schema are blocked until RUnlockSchema is called
+func (msti *MeasurementInfo) RLockSchema() {
+	msti.lockOfSchema().RLock()
+}
+
+func (msti *MeasurementInfo) RUnlockSchema() {
+	msti.lockOfSchema().RUnlock()
+}
+
+const maxTagKeysCacheItems = 64
+
+// tagKeysCache memoizes the tag keys matched by MatchTagKeys, keyed by the condition
+type tagKeysCache struct {
+	mu      sync.RWMutex
+	version uint64
+	items   map[string][]string
+}
+
+func newTagKeysCache() *tagKeysCache {
+	return &tagKeysCache{items: make(map[string][]string)}
+}
+
+func (c *tagKeysCache) get(version uint64, cond string) ([]string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.version != version {
+		return nil, false
+	}
+	keys, ok := c.items[cond]
+	return keys, ok
+}
+
+func (c *tagKeysCache) set(version uint64, cond string, keys []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.version != version || len(c.items) >= maxTagKeysCacheItems {
+		c.version = version
+		c.items = make(map[string][]string)
 	}
+	c.items[cond] = keys
+}
+
+func (msti *MeasurementInfo) SchemaChanged() {
+	atomic.AddUint64(&msti.schemaVersion, 1)
+}
+
+// Touch records updatedAt, in unix nanoseconds, as the last schema change of the measurement.
+// Callers that modify Schema directly should call it, with the time stamped in the command when applied by the FSM
+func (msti *MeasurementInfo) Touch(updatedAt int64) {
+	msti.UpdatedAt = updatedAt
 }
 
 func (msti *MeasurementInfo) OriginName() string {
 	return msti.originName
 }
 
-func (msti *MeasurementInfo) walkSchema(fn func(fieldName string, fieldType int32)) {
-	for fieldName := range msti.Schema {
-		fn(fieldName, msti.Schema[fieldName].Type)
+func (msti *MeasurementInfo) GetTTL() int64 {
+	return msti.TTL
+}
+
+// MarkDeletedHook is called with the measurement name whenever MarkDeleted of a measurement flips
+// by a command applied to Data. Measurements decoded by unmarshal are not reported, their previous state is unknown
+type MarkDeletedHook func(name string, old, new bool)
+
+var onMarkDeleted atomic.Value
+
+func init() {
+	onMarkDeleted.Store(MarkDeletedHook(nil))
+}
+
+// SetOnMarkDeleted registers the hook called when MarkDeleted of any measurement flips, nil disables it
+func SetOnMarkDeleted(hook MarkDeletedHook) {
+	onMarkDeleted.Store(hook)
+}
+
+func notifyMarkDeleted(name string, old, new bool) {
+	if old == new {
+		return
+	}
+	if hook := onMarkDeleted.Load().(MarkDeletedHook); hook != nil {
+		hook(name, old, new)
 	}
 }
 
-func (msti *MeasurementInfo) GetShardKey(ID uint64) *ShardKeyInfo {
-	for i := len(msti.ShardKeys) - 1; i >= 0; i-- {
-		if msti.ShardKeys[i].ShardGroup <= ID {
-			return &msti.ShardKeys[i]
+// SetMarkDeleted sets MarkDeleted of the measurement and reports the transition to the MarkDeletedHook
+func (msti *MeasurementInfo) SetMarkDeleted(deleted bool) {
+	old := msti.MarkDeleted
+	msti.MarkDeleted = deleted
+	notifyMarkDeleted(msti.Name, old, deleted)
+}
+
+// Undelete reverts MarkDeleted of a measurement whose data has not been physically removed yet,
+// removed reports whether the physical deletion has already run, nil means it has not
+func (msti *MeasurementInfo) Undelete(removed func() bool) error {
+	if !msti.MarkDeleted {
+		return nil
+	}
+	if removed != nil && removed() {
+		return ErrMeasurementRemoved
+	}
+	msti.SetMarkDeleted(false)
+	return nil
+}
+
+// EffectiveRetention returns the retention of the measurement in nanoseconds,
+// RetentionOverride takes precedence over TTL, dbDefault is used if neither is set
+func (msti *MeasurementInfo) EffectiveRetention(dbDefault int64) int64 {
+	if msti.RetentionOverride != nil {
+		return *msti.RetentionOverride
+	}
+	if msti.TTL > 0 {
+		return msti.TTL
+	}
+	return dbDefault
+}
+
+func (msti *MeasurementInfo) SetCardinalityEstimate(n uint64) {
+	msti.EstimatedCardinality = n
+}
+
+// WalkSchemaSorted calls fn with the fields in the lexical order of their names,
+// it is used where the output must be stable, such as schema dumps and diffs.
+// fn is called on a snapshot of the schema, so it may call the other methods of the measurement
+func (msti *MeasurementInfo) WalkSchemaSorted(fn func(fieldName string, fieldType int32)) {
+	schema := msti.cloneSchema()
+	names := make([]string, 0, len(schema))
+	for fieldName := range schema {
+		names = append(names, fieldName)
+	}
+	sort.Strings(names)
+
+	for _, fieldName := range names {
+		fn(fieldName, schema[fieldName].Type)
+	}
+}
+
+// AddField adds a field to the schema, it is a no-op if the field already exists with the same type
+func (msti *MeasurementInfo) AddField(name string, typ int32) error {
+	lock := msti.lockOfSchema()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if msti.Schema == nil {
+		msti.Schema = make(map[string]KeyInfo)
+	}
+
+	if exist, ok := msti.Schema[name]; ok {
+		if exist.Type != typ {
+			return ErrFieldTypeConflict
+		}
+		return nil
+	}
+
+	msti.Schema[name] = KeyInfo{Type: typ}
+	msti.SchemaChanged()
+	msti.Touch(time.Now().UnixNano())
+	return nil
+}
+
+func (msti *MeasurementInfo) AddTag(name string) error {
+	return msti.AddField(name, influx.Field_Type_Tag)
+}
+
+// RenameField renames a tag or a field and keeps its key info,
+// shard keys, index lists and aliases referring to it are updated as well
+func (msti *MeasurementInfo) RenameField(oldName, newName string) error {
+	lock := msti.lockOfSchema()
+	lock.Lock()
+	defer lock.Unlock()
+
+	info, ok := msti.Schema[oldName]
+	if !ok {
+		return ErrFieldNotFound
+	}
+
+	if oldName == newName {
+		return nil
+	}
+
+	if exist, ok := msti.Schema[newName]; ok {
+		if exist.Type != info.Type {
+			return ErrFieldTypeConflict
+		}
+		return ErrFieldExists
+	}
+
+	delete(msti.Schema, oldName)
+	msti.Schema[newName] = info
+	if info.Type == influx.Field_Type_Tag {
+		msti.renameShardKey(oldName, newName)
+	}
+	msti.renameIndexColumn(oldName, newName)
+	msti.renameAliasTarget(oldName, newName)
+	msti.SchemaChanged()
+	msti.Touch(time.Now().UnixNano())
+	return nil
+}
+
+// CreateFields adds the fields missing in the schema with the key IDs returned by nextID,
+// the schema is replaced by an updated copy so that it is left unchanged if any field conflicts.
+// updatedAt is recorded by Touch if any field is added and it is not 0
+func (msti *MeasurementInfo) CreateFields(fields []*proto2.FieldSchema, nextID func() uint64, updatedAt int64) error {
+	lock := msti.lockOfSchema()
+	lock.Lock()
+	defer lock.Unlock()
+
+	schema := make(map[string]KeyInfo, len(msti.Schema)+len(fields))
+	for name, info := range msti.Schema {
+		schema[name] = info
+	}
+
+	created := false
+	for _, field := range fields {
+		exist, ok := schema[field.GetFieldName()]
+		if !ok {
+			schema[field.GetFieldName()] = KeyInfo{ID: nextID(), Type: field.GetFieldType()}
+			created = true
+			continue
+		}
+		if exist.Type != field.GetFieldType() {
+			return ErrFieldTypeConflict
 		}
 	}
+
+	msti.Schema = schema
+	msti.SchemaChanged()
+	if created && updatedAt != 0 {
+		msti.Touch(updatedAt)
+	}
 	return nil
 }
 
+// RefKey increases the reference count of the key whose ID is id, it is a no-op if there is no such key
+func (msti *MeasurementInfo) RefKey(id uint64) {
+	lock := msti.lockOfSchema()
+	lock.Lock()
+	defer lock.Unlock()
+
+	for name, info := range msti.Schema {
+		if info.ID == id {
+			info.Ref++
+			msti.Schema[name] = info
+			return
+		}
+	}
+}
+
+// UnrefKey decreases the reference count of the key whose ID is id, the key is removed once it is not referenced
+func (msti *MeasurementInfo) UnrefKey(id uint64) {
+	lock := msti.lockOfSchema()
+	lock.Lock()
+	defer lock.Unlock()
+
+	for name, info := range msti.Schema {
+		if info.ID != id {
+			continue
+		}
+		info.Ref--
+		if info.Ref <= 0 {
+			delete(msti.Schema, name)
+			msti.SchemaChanged()
+		} else {
+			msti.Schema[name] = info
+		}
+		return
+	}
+}
+
+func (msti *MeasurementInfo) renameShardKey(oldName, newName string) {
+	for i := range msti.ShardKeys {
+		keys := msti.ShardKeys[i].ShardKey
+		renamed := false
+		for j := range keys {
+			if keys[j] == oldName {
+				keys[j] = newName
+				renamed = true
+			}
+		}
+		if renamed {
+			sort.Strings(keys)
+		}
+	}
+}
+
+// renameIndexColumn replaces the index lists referring to oldName,
+// the copies returned by GetIndexRelation keep the old ones
+func (msti *MeasurementInfo) renameIndexColumn(oldName, newName string) {
+	indexR := &msti.IndexRelation
+	var lists []*IndexList
+	for i, l := range indexR.IndexList {
+		if l == nil || !containsString(l.IList, oldName) {
+			continue
+		}
+		if lists == nil {
+			lists = make([]*IndexList, len(indexR.IndexList))
+			copy(lists, indexR.IndexList)
+		}
+		lst := make([]string, len(l.IList))
+		for j, column := range l.IList {
+			if column == oldName {
+				column = newName
+			}
+			lst[j] = column
+		}
+		lists[i] = &IndexList{IList: lst}
+	}
+	if lists != nil {
+		indexR.IndexList = lists
+	}
+}
+
+// renameAliasTarget points the aliases of oldName to newName, the aliases are replaced by an updated copy
+func (msti *MeasurementInfo) renameAliasTarget(oldName, newName string) {
+	renamed := false
+	for _, name := range msti.Aliases {
+		if name == oldName {
+			renamed = true
+			break
+		}
+	}
+	if !renamed {
+		return
+	}
+
+	aliases := make(map[string]string, len(msti.Aliases))
+	for alias, name := range msti.Aliases {
+		if name == oldName {
+			name = newName
+		}
+		aliases[alias] = name
+	}
+	msti.Aliases = aliases
+}
+
+// DropField removes a field or a tag from the schema and reports whether it existed,
+// the time field and tags used as shard keys can not be dropped
+func (msti *MeasurementInfo) DropField(name string) (bool, error) {
+	lock := msti.lockOfSchema()
+	lock.Lock()
+	defer lock.Unlock()
+
+	return msti.dropField(name)
+}
+
+// dropField is DropField without locking Schema, the caller must hold the schema lock
+func (msti *MeasurementInfo) dropField(name string) (bool, error) {
+	if name == record.TimeField {
+		return false, ErrDropTimeField
+	}
+
+	info, ok := msti.Schema[name]
+	if !ok {
+		return false, nil
+	}
+
+	if info.Type == influx.Field_Type_Tag && msti.isShardKey(name) {
+		return false, ErrDropShardKey
+	}
+
+	delete(msti.Schema, name)
+	msti.SchemaChanged()
+	msti.Touch(time.Now().UnixNano())
+	return true, nil
+}
+
+func (msti *MeasurementInfo) isShardKey(name string) bool {
+	for i := range msti.ShardKeys {
+		for _, key := range msti.ShardKeys[i].ShardKey {
+			if key == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DropFieldAndIndex drops a field like DropField and also removes it from the index lists of IndexRelation.
+// An index left without any column is removed if allowEmptyIndex is true, otherwise ErrIndexEmpty is returned
+func (msti *MeasurementInfo) DropFieldAndIndex(name string, allowEmptyIndex bool) error {
+	lock := msti.lockOfSchema()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, ok := msti.Schema[name]; !ok && name != record.TimeField {
+		return ErrFieldNotFound
+	}
+
+	indexR := &msti.IndexRelation
+	emptyIndex := make([]bool, len(indexR.IndexList))
+	for i, l := range indexR.IndexList {
+		if l == nil || !containsString(l.IList, name) {
+			continue
+		}
+		if len(l.IList) == 1 {
+			if !allowEmptyIndex {
+				return ErrIndexEmpty
+			}
+			emptyIndex[i] = true
+		}
+	}
+
+	if _, err := msti.dropField(name); err != nil {
+		return err
+	}
+
+	// the index lists are replaced, the copies returned by GetIndexRelation keep the old ones
+	lists := make([]*IndexList, len(indexR.IndexList))
+	for i, l := range indexR.IndexList {
+		lists[i] = l
+		if l == nil || emptyIndex[i] {
+			continue
+		}
+		lst := l.IList[:0:0]
+		for _, column := range l.IList {
+			if column != name {
+				lst = append(lst, column)
+			}
+		}
+		lists[i] = &IndexList{IList: lst}
+	}
+	indexR.IndexList = lists
+
+	for i := len(emptyIndex) - 1; i >= 0; i-- {
+		if emptyIndex[i] {
+			indexR.removeIndex(i)
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateShardKeys checks that every shard key is a tag of the measurement and the shard type is supported
+func (msti *MeasurementInfo) ValidateShardKeys() error {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
+	var invalidKeys, invalidTypes []string
+	for i := range msti.ShardKeys {
+		ski := &msti.ShardKeys[i]
+		if ski.Type != HASH && ski.Type != RANGE {
+			invalidTypes = append(invalidTypes, ski.Type)
+		}
+		for _, key := range ski.ShardKey {
+			if info, ok := msti.Schema[key]; !ok || info.Type != influx.Field_Type_Tag {
+				invalidKeys = append(invalidKeys, key)
+			}
+		}
+	}
+
+	if len(invalidKeys) == 0 && len(invalidTypes) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w of measurement %s: keys not defined as tags %v, unsupported types %q",
+		ErrInvalidShardKey, msti.Name, invalidKeys, invalidTypes)
+}
+
+// SetShardKey validates ski and sets it as the shard key effective from ski.ShardGroup,
+// an existing shard key of the same shard group is replaced
+func (msti *MeasurementInfo) SetShardKey(ski ShardKeyInfo) error {
+	if err := ski.Validate(); err != nil {
+		return err
+	}
+
+	i := sort.Search(len(msti.ShardKeys), func(i int) bool {
+		return msti.ShardKeys[i].ShardGroup >= ski.ShardGroup
+	})
+	if i < len(msti.ShardKeys) && msti.ShardKeys[i].ShardGroup == ski.ShardGroup {
+		msti.ShardKeys[i] = ski.clone()
+		return nil
+	}
+
+	msti.ShardKeys = append(msti.ShardKeys, ShardKeyInfo{})
+	copy(msti.ShardKeys[i+1:], msti.ShardKeys[i:])
+	msti.ShardKeys[i] = ski.clone()
+	return nil
+}
+
+// GetShardKey returns the last shard key whose shard group is not greater than ID,
+// ShardKeys are ordered by ShardGroup
+func (msti *MeasurementInfo) GetShardKey(ID uint64) *ShardKeyInfo {
+	i := sort.Search(len(msti.ShardKeys), func(i int) bool {
+		return msti.ShardKeys[i].ShardGroup > ID
+	})
+	if i == 0 {
+		return nil
+	}
+	return &msti.ShardKeys[i-1]
+}
+
 func (msti *MeasurementInfo) marshal() *proto2.MeasurementInfo {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
 	pb := &proto2.MeasurementInfo{
 		Name:        proto.String(msti.Name),
 		MarkDeleted: proto.Bool(msti.MarkDeleted),
+		TTL:         proto.Int64(msti.TTL),
+
+		EstimatedCardinality: proto.Uint64(msti.EstimatedCardinality),
+		UpdatedAt:            proto.Int64(msti.UpdatedAt),
+	}
+	if msti.RetentionOverride != nil {
+		pb.RetentionOverride = proto.Int64(*msti.RetentionOverride)
+	}
+
+	if msti.Aliases != nil {
+		pb.Aliases = msti.cloneAliases()
+	}
+
+	if len(msti.DownSamplePolicies) > 0 {
+		pb.DownSamplePolicies = make([]*proto2.MeasurementDownSamplePolicy, len(msti.DownSamplePolicies))
+		for i := range msti.DownSamplePolicies {
+			pb.DownSamplePolicies[i] = msti.DownSamplePolicies[i].marshal()
+		}
 	}
 
 	if msti.ShardKeys != nil {
@@ -109,6 +641,27 @@ func (msti *MeasurementInfo) unmarshal(pb *proto2.MeasurementInfo) {
 	msti.Name = pb.GetName()
 	msti.originName = influx.GetOriginMstName(msti.Name)
 	msti.MarkDeleted = pb.GetMarkDeleted()
+	msti.TTL = pb.GetTTL()
+	msti.EstimatedCardinality = pb.GetEstimatedCardinality()
+	msti.UpdatedAt = pb.GetUpdatedAt()
+	msti.RetentionOverride = nil
+	if pb.RetentionOverride != nil {
+		msti.RetentionOverride = proto.Int64(pb.GetRetentionOverride())
+	}
+	msti.Aliases = nil
+	if len(pb.GetAliases()) > 0 {
+		msti.Aliases = make(map[string]string, len(pb.GetAliases()))
+		for alias, name := range pb.GetAliases() {
+			msti.Aliases[alias] = name
+		}
+	}
+	msti.DownSamplePolicies = nil
+	if len(pb.GetDownSamplePolicies()) > 0 {
+		msti.DownSamplePolicies = make([]MeasurementDownSamplePolicy, len(pb.GetDownSamplePolicies()))
+		for i, p := range pb.GetDownSamplePolicies() {
+			msti.DownSamplePolicies[i].unmarshal(p)
+		}
+	}
 	if pb.GetShardKeys() != nil {
 		msti.ShardKeys = make([]ShardKeyInfo, len(pb.GetShardKeys()))
 		for i := range pb.GetShardKeys() {
@@ -129,6 +682,13 @@ func (msti *MeasurementInfo) unmarshal(pb *proto2.MeasurementInfo) {
 	}
 
 	msti.IndexRelation.unmarshal(pb.GetIndexRelation())
+	msti.SchemaChanged()
+	if msti.tagKeysCache == nil {
+		msti.tagKeysCache = newTagKeysCache()
+	}
+	if msti.schemaLock == nil {
+		msti.schemaLock = &sync.RWMutex{}
+	}
 }
 
 func (msti *MeasurementInfo) MarshalBinary() ([]byte, error) {
@@ -145,9 +705,44 @@ func (msti *MeasurementInfo) UnmarshalBinary(buf []byte) error {
 	return nil
 }
 
-func (msti MeasurementInfo) clone() *MeasurementInfo {
-	other := msti
+// UnmarshalBinaryStrict is like UnmarshalBinary, but rejects the payload
+// if it changes the type of any existing field
+func (msti *MeasurementInfo) UnmarshalBinaryStrict(buf []byte) error {
+	pb := &proto2.MeasurementInfo{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return err
+	}
+	if err := msti.validateSchema(pb.GetSchema()); err != nil {
+		return err
+	}
+	msti.unmarshal(pb)
+	return nil
+}
+
+func (msti *MeasurementInfo) validateSchema(schema map[string]*proto2.KeyInfo) error {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
+	for name, t := range schema {
+		exist, ok := msti.Schema[name]
+		if ok && exist.Type != t.GetType() {
+			return fmt.Errorf("%w: field %s type changes from %d to %d", ErrFieldTypeConflict, name, exist.Type, t.GetType())
+		}
+	}
+	return nil
+}
+
+func (msti *MeasurementInfo) clone() *MeasurementInfo {
+	other := *msti
 	other.Schema = msti.cloneSchema()
+	other.Aliases = msti.cloneAliases()
+	other.DownSamplePolicies = msti.ListDownSamplePolicies()
+	other.tagKeysCache = newTagKeysCache()
+	other.schemaLock = &sync.RWMutex{}
+	other.schemaVersion = atomic.LoadUint64(&msti.schemaVersion)
+	if msti.RetentionOverride != nil {
+		other.RetentionOverride = proto.Int64(*msti.RetentionOverride)
+	}
 	if msti.ShardKeys == nil {
 		return &other
 	}
@@ -159,7 +754,9 @@ func (msti MeasurementInfo) clone() *MeasurementInfo {
 	return &other
 }
 
-func (msti MeasurementInfo) cloneSchema() map[string]KeyInfo {
+func (msti *MeasurementInfo) cloneSchema() map[string]KeyInfo {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
 	if msti.Schema == nil {
 		return nil
 	}
@@ -171,16 +768,112 @@ func (msti MeasurementInfo) cloneSchema() map[string]KeyInfo {
 	return schema
 }
 
-func (msti MeasurementInfo) FieldKeys(ret map[string]map[string]int32) {
+func (msti *MeasurementInfo) cloneAliases() map[string]string {
+	if msti.Aliases == nil {
+		return nil
+	}
+
+	aliases := make(map[string]string, len(msti.Aliases))
+	for alias, name := range msti.Aliases {
+		aliases[alias] = name
+	}
+	return aliases
+}
+
+// ResolveField returns the canonical name of a field or tag,
+// name is looked up in Schema first, and then in Aliases
+func (msti *MeasurementInfo) ResolveField(name string) (string, bool) {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
+	if _, ok := msti.Schema[name]; ok {
+		return name, true
+	}
+	canonical, ok := msti.Aliases[name]
+	return canonical, ok
+}
+
+func (msti *MeasurementInfo) FieldKeys(ret map[string]map[string]int32) {
+	for key, typ := range msti.FieldKeysOnly() {
+		ret[msti.OriginName()][key] = typ
+	}
+}
+
+// FieldKeysOnly returns the fields of the measurement with their types, tags are excluded
+func (msti *MeasurementInfo) FieldKeysOnly() map[string]int32 {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
+	fields := make(map[string]int32, len(msti.Schema))
 	for key := range msti.Schema {
 		if msti.Schema[key].Type == influx.Field_Type_Tag {
 			continue
 		}
-		ret[msti.OriginName()][key] = msti.Schema[key].Type
+		fields[key] = msti.Schema[key].Type
 	}
+	return fields
 }
 
-func (msti MeasurementInfo) MatchTagKeys(cond influxql.Expr, ret map[string]map[string]struct{}) {
+// FieldCounts returns the number of tags/fields of each type, keyed by influx.Field_Type_*
+func (msti *MeasurementInfo) FieldCounts() map[int32]int {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
+	counts := make(map[int32]int)
+	for key := range msti.Schema {
+		counts[msti.Schema[key].Type]++
+	}
+	return counts
+}
+
+// TagKeys returns the sorted tag keys of the measurement
+func (msti *MeasurementInfo) TagKeys() []string {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
+	keys := make([]string, 0, len(msti.Schema))
+	for key := range msti.Schema {
+		if msti.Schema[key].Type == influx.Field_Type_Tag {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// MatchTagKeys adds the tag keys matching cond to ret, the result is cached until the schema changes
+func (msti *MeasurementInfo) MatchTagKeys(cond influxql.Expr, ret map[string]map[string]struct{}) {
+	if msti.tagKeysCache == nil {
+		msti.MatchTagKeysNoCache(cond, ret)
+		return
+	}
+
+	condStr := ""
+	if cond != nil {
+		condStr = cond.String()
+	}
+	version := atomic.LoadUint64(&msti.schemaVersion)
+	keys, ok := msti.tagKeysCache.get(version, condStr)
+	if !ok {
+		matched := map[string]map[string]struct{}{msti.Name: {}}
+		msti.MatchTagKeysNoCache(cond, matched)
+		keys = make([]string, 0, len(matched[msti.Name]))
+		for key := range matched[msti.Name] {
+			keys = append(keys, key)
+		}
+		msti.tagKeysCache.set(version, condStr, keys)
+	}
+
+	for _, key := range keys {
+		ret[msti.Name][key] = struct{}{}
+	}
+}
+
+// MatchTagKeysNoCache is like MatchTagKeys, but always evaluates cond against the schema
+func (msti *MeasurementInfo) MatchTagKeysNoCache(cond influxql.Expr, ret map[string]map[string]struct{}) {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
 	for key, inf := range msti.Schema {
 		if inf.Type != influx.Field_Type_Tag {
 			continue
@@ -195,6 +888,102 @@ func (msti MeasurementInfo) MatchTagKeys(cond influxql.Expr, ret map[string]map[
 	}
 }
 
+type SchemaDiff struct {
+	AddedFields          []string
+	RemovedFields        []string
+	TypeChangedFields    []string
+	ShardKeysChanged     bool
+	IndexRelationChanged bool
+}
+
+func (d SchemaDiff) Empty() bool {
+	return len(d.AddedFields) == 0 && len(d.RemovedFields) == 0 && len(d.TypeChangedFields) == 0 &&
+		!d.ShardKeysChanged && !d.IndexRelationChanged
+}
+
+// Diff returns the changes from msti to other, field names are sorted
+func (msti *MeasurementInfo) Diff(other *MeasurementInfo) SchemaDiff {
+	// the schemas are compared on snapshots, so the two schema locks are never held together
+	schema, otherSchema := msti.cloneSchema(), other.cloneSchema()
+
+	var diff SchemaDiff
+	for name, info := range otherSchema {
+		old, ok := schema[name]
+		if !ok {
+			diff.AddedFields = append(diff.AddedFields, name)
+		} else if old.Type != info.Type {
+			diff.TypeChangedFields = append(diff.TypeChangedFields, name)
+		}
+	}
+	for name := range schema {
+		if _, ok := otherSchema[name]; !ok {
+			diff.RemovedFields = append(diff.RemovedFields, name)
+		}
+	}
+	sort.Strings(diff.AddedFields)
+	sort.Strings(diff.RemovedFields)
+	sort.Strings(diff.TypeChangedFields)
+
+	indexR, otherIndexR := msti.GetIndexRelation(), other.GetIndexRelation()
+	diff.ShardKeysChanged = !shardKeysEqual(msti.ShardKeys, other.ShardKeys)
+	diff.IndexRelationChanged = !indexR.equal(&otherIndexR)
+	return diff
+}
+
+// Equal reports whether the two measurements have the same definition,
+// nil and empty maps/slices are treated as equal
+func (msti *MeasurementInfo) Equal(other *MeasurementInfo) bool {
+	if msti == nil || other == nil {
+		return msti == other
+	}
+
+	// the schemas are compared on snapshots, so the two schema locks are never held together
+	schema, otherSchema := msti.cloneSchema(), other.cloneSchema()
+	if msti.Name != other.Name || msti.MarkDeleted != other.MarkDeleted || msti.TTL != other.TTL ||
+		msti.EstimatedCardinality != other.EstimatedCardinality || len(schema) != len(otherSchema) ||
+		len(msti.Aliases) != len(other.Aliases) || len(msti.DownSamplePolicies) != len(other.DownSamplePolicies) {
+		return false
+	}
+
+	if (msti.RetentionOverride == nil) != (other.RetentionOverride == nil) ||
+		(msti.RetentionOverride != nil && *msti.RetentionOverride != *other.RetentionOverride) {
+		return false
+	}
+
+	for name, info := range schema {
+		if o, ok := otherSchema[name]; !ok || o != info {
+			return false
+		}
+	}
+
+	for alias, name := range msti.Aliases {
+		if o, ok := other.Aliases[alias]; !ok || o != name {
+			return false
+		}
+	}
+
+	for i := range msti.DownSamplePolicies {
+		if !msti.DownSamplePolicies[i].Equal(&other.DownSamplePolicies[i]) {
+			return false
+		}
+	}
+
+	indexR, otherIndexR := msti.GetIndexRelation(), other.GetIndexRelation()
+	return shardKeysEqual(msti.ShardKeys, other.ShardKeys) && indexR.equal(&otherIndexR)
+}
+
+func shardKeysEqual(a, b []ShardKeyInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ShardGroup != b[i].ShardGroup || !a[i].EqualsToAnother(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 type ShardKeyInfo struct {
 	ShardKey   []string
 	Type       string
@@ -220,6 +1009,27 @@ func (ski *ShardKeyInfo) EqualsToAnother(other *ShardKeyInfo) bool {
 	return true
 }
 
+// Validate checks the sharding strategy is supported and the shard keys are non-empty, sorted and unique
+func (ski *ShardKeyInfo) Validate() error {
+	if ski.Type != HASH && ski.Type != RANGE {
+		return fmt.Errorf("%w: unsupported type %q", ErrInvalidShardKey, ski.Type)
+	}
+
+	if len(ski.ShardKey) == 0 {
+		return ErrShardKeyRequired
+	}
+
+	for i := 1; i < len(ski.ShardKey); i++ {
+		if ski.ShardKey[i] == ski.ShardKey[i-1] {
+			return ErrDuplicateShardKey
+		}
+		if ski.ShardKey[i] < ski.ShardKey[i-1] {
+			return fmt.Errorf("%w: keys %v are not sorted", ErrInvalidShardKey, ski.ShardKey)
+		}
+	}
+	return nil
+}
+
 func (ski *ShardKeyInfo) Marshal() *proto2.ShardKeyInfo {
 	pb := &proto2.ShardKeyInfo{ShardKey: ski.ShardKey, Type: proto.String(ski.Type)}
 	if ski.ShardGroup > 0 {
@@ -289,53 +1099,315 @@ func (indexR *IndexRelation) unmarshal(pb *proto2.IndexRelation) {
 	}
 }
 
-func (msti *MeasurementInfo) ContainIndexRelation(ID uint64) bool {
+// removeIndex removes the i-th index, IndexNames is only kept aligned when it is set for every index
+func (indexR *IndexRelation) removeIndex(i int) {
+	if len(indexR.IndexNames) == len(indexR.Oids) {
+		indexR.IndexNames = append(indexR.IndexNames[:i:i], indexR.IndexNames[i+1:]...)
+	}
+	if i < len(indexR.Oids) {
+		indexR.Oids = append(indexR.Oids[:i:i], indexR.Oids[i+1:]...)
+	}
+	if i < len(indexR.IndexList) {
+		indexR.IndexList = append(indexR.IndexList[:i:i], indexR.IndexList[i+1:]...)
+	}
+}
+
+// AddIndex appends an index and keeps Oids, IndexNames and IndexList aligned
+func (indexR *IndexRelation) AddIndex(oid uint32, name string, columns []string) error {
+	if len(indexR.IndexNames) != len(indexR.Oids) || len(indexR.IndexList) != len(indexR.Oids) {
+		return ErrIndexRelationInconsistent
+	}
+	if len(columns) == 0 {
+		return ErrIndexEmpty
+	}
+	if containsString(indexR.IndexNames, name) {
+		return ErrIndexExists
+	}
+
+	indexR.Oids = append(indexR.Oids, oid)
+	indexR.IndexNames = append(indexR.IndexNames, name)
+	indexR.IndexList = append(indexR.IndexList, &IndexList{IList: append([]string(nil), columns...)})
+	return nil
+}
+
+// RemoveIndex removes the index with the given name and reports whether it existed
+func (indexR *IndexRelation) RemoveIndex(name string) bool {
+	for i := range indexR.IndexNames {
+		if indexR.IndexNames[i] == name {
+			indexR.removeIndex(i)
+			return true
+		}
+	}
+	return false
+}
+
+func (indexR *IndexRelation) equal(other *IndexRelation) bool {
+	if indexR.Rid != other.Rid || len(indexR.Oids) != len(other.Oids) ||
+		len(indexR.IndexNames) != len(other.IndexNames) || len(indexR.IndexList) != len(other.IndexList) {
+		return false
+	}
+
+	for i := range indexR.Oids {
+		if indexR.Oids[i] != other.Oids[i] {
+			return false
+		}
+	}
+
+	for i := range indexR.IndexNames {
+		if indexR.IndexNames[i] != other.IndexNames[i] {
+			return false
+		}
+	}
+
+	for i := range indexR.IndexList {
+		l, o := indexR.IndexList[i].columns(), other.IndexList[i].columns()
+		if len(l) != len(o) {
+			return false
+		}
+		for j := range l {
+			if l[j] != o[j] {
+				return false
+			}
+		}
+	}
 	return true
 }
 
+func (il *IndexList) columns() []string {
+	if il == nil {
+		return nil
+	}
+	return il.IList
+}
+
+// ContainIndexRelation reports whether the measurement defines an index of type ID (the index oid)
+// with at least one indexed column
+func (msti *MeasurementInfo) ContainIndexRelation(ID uint64) bool {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+
+	for i, oid := range msti.IndexRelation.Oids {
+		if uint64(oid) != ID || i >= len(msti.IndexRelation.IndexList) {
+			continue
+		}
+		if l := msti.IndexRelation.IndexList[i]; l != nil && len(l.IList) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetIndexRelation returns a copy of IndexRelation read under the schema lock,
+// DropFieldAndIndex replaces the index lists instead of changing them, so the copy is not affected by it
 func (msti *MeasurementInfo) GetIndexRelation() IndexRelation {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
 	return msti.IndexRelation
 }
 
 func (msti *MeasurementInfo) FindMstInfos(dataTypes []int64) []*MeasurementTypeFields {
 	infos := make([]*MeasurementTypeFields, 0, len(dataTypes))
+	buckets := make(map[int32][]*MeasurementTypeFields, len(dataTypes))
 	for _, d := range dataTypes {
+		fieldType, ok := dataTypeToFieldType(influxql.DataType(d))
+		if !ok {
+			continue
+		}
 		info := &MeasurementTypeFields{
+			Type:   d,
 			Fields: make([]string, 0),
 		}
-		switch influxql.DataType(d) {
-		case influxql.Float:
-			info.Type = int64(influxql.Float)
-			for name, inf := range msti.Schema {
-				if inf.Type == influx.Field_Type_Float {
-					info.Fields = append(info.Fields, name)
-				}
-			}
-		case influxql.Integer:
-			info.Type = int64(influxql.Integer)
-			for name, inf := range msti.Schema {
-				if inf.Type == influx.Field_Type_Int {
-					info.Fields = append(info.Fields, name)
-				}
-			}
-		case influxql.String:
-			info.Type = int64(influxql.String)
-			for name, inf := range msti.Schema {
-				if inf.Type == influx.Field_Type_String {
-					info.Fields = append(info.Fields, name)
-				}
+		infos = append(infos, info)
+		buckets[fieldType] = append(buckets[fieldType], info)
+	}
+
+	msti.WalkSchemaSorted(func(fieldName string, fieldType int32) {
+		for _, info := range buckets[fieldType] {
+			info.Fields = append(info.Fields, fieldName)
+		}
+	})
+
+	n := 0
+	for _, info := range infos {
+		if len(info.Fields) > 0 {
+			infos[n] = info
+			n++
+		}
+	}
+	return infos[:n]
+}
+
+// FieldTypeCounts returns the number of schema entries of each influx.Field_Type_*, tags included.
+// It is an alias of FieldCounts
+func (msti *MeasurementInfo) FieldTypeCounts() map[int32]int {
+	return msti.FieldCounts()
+}
+
+// coercibleFieldTypes lists the types each numeric field type can be widened to without losing its meaning,
+// the type itself comes first
+var coercibleFieldTypes = map[int32][]int32{
+	influx.Field_Type_Int:   {influx.Field_Type_Int, influx.Field_Type_Float},
+	influx.Field_Type_UInt:  {influx.Field_Type_UInt, influx.Field_Type_Float},
+	influx.Field_Type_Float: {influx.Field_Type_Float},
+}
+
+// CoercibleTypes returns the numeric types the field can be safely widened to,
+// so results of a field whose type changed over its history can share an output type.
+// nil is returned for tags, non-numeric fields and unknown fields
+func (msti *MeasurementInfo) CoercibleTypes(field string) []int32 {
+	msti.RLockSchema()
+	info, ok := msti.Schema[field]
+	msti.RUnlockSchema()
+	if !ok {
+		return nil
+	}
+	types, ok := coercibleFieldTypes[info.Type]
+	if !ok {
+		return nil
+	}
+	return append([]int32(nil), types...)
+}
+
+func dataTypeToFieldType(typ influxql.DataType) (int32, bool) {
+	switch typ {
+	case influxql.Float:
+		return influx.Field_Type_Float, true
+	case influxql.Integer:
+		return influx.Field_Type_Int, true
+	case influxql.String:
+		return influx.Field_Type_String, true
+	case influxql.Boolean:
+		return influx.Field_Type_Boolean, true
+	default:
+		return influx.Field_Type_Unknown, false
+	}
+}
+
+// MeasurementDownSamplePolicy downsamples the fields of one measurement into DestMeasurement,
+// unlike DownSamplePolicy of a retention policy it picks the aggregations per field
+type MeasurementDownSamplePolicy struct {
+	Interval        time.Duration
+	Calls           map[string][]string // field name -> aggregation functions
+	DestMeasurement string
+}
+
+func (p *MeasurementDownSamplePolicy) marshal() *proto2.MeasurementDownSamplePolicy {
+	pb := &proto2.MeasurementDownSamplePolicy{
+		Interval:        proto.Int64(int64(p.Interval)),
+		DestMeasurement: proto.String(p.DestMeasurement),
+	}
+
+	fields := make([]string, 0, len(p.Calls))
+	for field := range p.Calls {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	pb.Calls = make([]*proto2.DownSampleFieldCalls, len(fields))
+	for i, field := range fields {
+		pb.Calls[i] = &proto2.DownSampleFieldCalls{
+			Field:  proto.String(field),
+			AggOps: append([]string(nil), p.Calls[field]...),
+		}
+	}
+	return pb
+}
+
+func (p *MeasurementDownSamplePolicy) unmarshal(pb *proto2.MeasurementDownSamplePolicy) {
+	p.Interval = time.Duration(pb.GetInterval())
+	p.DestMeasurement = pb.GetDestMeasurement()
+	p.Calls = make(map[string][]string, len(pb.GetCalls()))
+	for _, c := range pb.GetCalls() {
+		p.Calls[c.GetField()] = append([]string(nil), c.GetAggOps()...)
+	}
+}
+
+func (p MeasurementDownSamplePolicy) clone() MeasurementDownSamplePolicy {
+	other := p
+	other.Calls = make(map[string][]string, len(p.Calls))
+	for field, ops := range p.Calls {
+		other.Calls[field] = append([]string(nil), ops...)
+	}
+	return other
+}
+
+func (p *MeasurementDownSamplePolicy) Equal(other *MeasurementDownSamplePolicy) bool {
+	if p.Interval != other.Interval || p.DestMeasurement != other.DestMeasurement || len(p.Calls) != len(other.Calls) {
+		return false
+	}
+	for field, ops := range p.Calls {
+		o, ok := other.Calls[field]
+		if !ok || len(o) != len(ops) {
+			return false
+		}
+		for i := range ops {
+			if ops[i] != o[i] {
+				return false
 			}
-		case influxql.Boolean:
-			info.Type = int64(influxql.Boolean)
-			for name, inf := range msti.Schema {
-				if inf.Type == influx.Field_Type_Boolean {
-					info.Fields = append(info.Fields, name)
-				}
+		}
+	}
+	return true
+}
+
+func (msti *MeasurementInfo) validateDownSamplePolicy(p *MeasurementDownSamplePolicy) error {
+	if p.Interval <= 0 {
+		return fmt.Errorf("invalid downsample interval %s", p.Interval)
+	}
+	if p.DestMeasurement == "" {
+		return fmt.Errorf("downsample destination measurement is required")
+	}
+	if len(p.Calls) == 0 {
+		return fmt.Errorf("downsample policy has no field to aggregate")
+	}
+	for field, ops := range p.Calls {
+		info, ok := msti.Schema[field]
+		if !ok || info.Type == influx.Field_Type_Tag {
+			return fmt.Errorf("%w: %s", ErrFieldNotFound, field)
+		}
+		if len(ops) == 0 {
+			return fmt.Errorf("no aggregation for field %s", field)
+		}
+		for _, op := range ops {
+			if !DownSampleSupportAgg[op] {
+				return fmt.Errorf("unsupported downsample aggregation %s for field %s", op, field)
 			}
 		}
-		if len(info.Fields) > 0 {
-			infos = append(infos, info)
+	}
+	return nil
+}
+
+// AddDownSamplePolicy adds a copy of p after checking that its fields exist and its aggregations are supported,
+// a measurement holds at most one policy per interval and destination
+func (msti *MeasurementInfo) AddDownSamplePolicy(p MeasurementDownSamplePolicy) error {
+	lock := msti.lockOfSchema()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := msti.validateDownSamplePolicy(&p); err != nil {
+		return err
+	}
+	for i := range msti.DownSamplePolicies {
+		exist := &msti.DownSamplePolicies[i]
+		if exist.Interval == p.Interval && exist.DestMeasurement == p.DestMeasurement {
+			return ErrDownSamplePolicyExists
 		}
 	}
-	return infos
+	msti.DownSamplePolicies = append(msti.DownSamplePolicies, p.clone())
+	msti.Touch(time.Now().UnixNano())
+	return nil
+}
+
+// ListDownSamplePolicies returns a deep copy of the downsample policies in the order they were added
+func (msti *MeasurementInfo) ListDownSamplePolicies() []MeasurementDownSamplePolicy {
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+	if len(msti.DownSamplePolicies) == 0 {
+		return nil
+	}
+
+	policies := make([]MeasurementDownSamplePolicy, len(msti.DownSamplePolicies))
+	for i := range msti.DownSamplePolicies {
+		policies[i] = msti.DownSamplePolicies[i].clone()
+	}
+	return policies
 }
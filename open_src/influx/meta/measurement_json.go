@@ -0,0 +1,121 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+)
+
+var fieldTypeJSONNames = map[int32]string{
+	influx.Field_Type_Int:     "integer",
+	influx.Field_Type_UInt:    "unsigned",
+	influx.Field_Type_Float:   "float",
+	influx.Field_Type_String:  "string",
+	influx.Field_Type_Boolean: "boolean",
+	influx.Field_Type_Tag:     "tag",
+}
+
+var fieldTypeJSONValues = func() map[string]int32 {
+	m := make(map[string]int32, len(fieldTypeJSONNames))
+	for typ, name := range fieldTypeJSONNames {
+		m[name] = typ
+	}
+	return m
+}()
+
+type keyInfoJSON struct {
+	ID   uint64
+	Ref  int32
+	Type string
+}
+
+type measurementInfoJSON struct {
+	Name          string
+	Schema        map[string]keyInfoJSON `json:",omitempty"`
+	ShardKeys     []ShardKeyInfo         `json:",omitempty"`
+	IndexRelation IndexRelation
+}
+
+// MarshalJSON encodes the name, schema, shard keys and index relation of the measurement,
+// field types are rendered as readable names such as "tag" and "float"
+func (msti *MeasurementInfo) MarshalJSON() ([]byte, error) {
+	v := measurementInfoJSON{
+		Name:          msti.Name,
+		ShardKeys:     msti.ShardKeys,
+		IndexRelation: msti.IndexRelation,
+	}
+
+	msti.RLockSchema()
+	defer msti.RUnlockSchema()
+	if msti.Schema != nil {
+		var err error
+		v.Schema = make(map[string]keyInfoJSON, len(msti.Schema))
+		msti.WalkSchemaSorted(func(name string, fieldType int32) {
+			typ, ok := fieldTypeJSONNames[fieldType]
+			if !ok {
+				if err == nil {
+					err = fmt.Errorf("unknown type %d of field %s", fieldType, name)
+				}
+				return
+			}
+			info := msti.Schema[name]
+			v.Schema[name] = keyInfoJSON{ID: info.ID, Ref: info.Ref, Type: typ}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes data encoded by MarshalJSON
+func (msti *MeasurementInfo) UnmarshalJSON(data []byte) error {
+	var v measurementInfoJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	var schema map[string]KeyInfo
+	if v.Schema != nil {
+		schema = make(map[string]KeyInfo, len(v.Schema))
+		for name, info := range v.Schema {
+			typ, ok := fieldTypeJSONValues[info.Type]
+			if !ok {
+				return fmt.Errorf("unknown type %q of field %s", info.Type, name)
+			}
+			schema[name] = KeyInfo{ID: info.ID, Ref: info.Ref, Type: typ}
+		}
+	}
+
+	msti.Name = v.Name
+	msti.originName = influx.GetOriginMstName(v.Name)
+	msti.Schema = schema
+	msti.ShardKeys = v.ShardKeys
+	msti.IndexRelation = v.IndexRelation
+	if msti.tagKeysCache == nil {
+		msti.tagKeysCache = newTagKeysCache()
+	}
+	if msti.schemaLock == nil {
+		msti.schemaLock = &sync.RWMutex{}
+	}
+	msti.SchemaChanged()
+	return nil
+}
@@ -0,0 +1,1214 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	proto2 "github.com/openGemini/openGemini/open_src/influx/meta/proto"
+	"github.com/openGemini/openGemini/open_src/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasurementInfo_TTL(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.Equal(t, int64(0), msti.GetTTL())
+
+	msti.TTL = int64(24 * time.Hour)
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, int64(24*time.Hour), other.GetTTL())
+	require.Equal(t, "mst", other.OriginName())
+
+	cloned := other.clone()
+	require.Equal(t, other.GetTTL(), cloned.GetTTL())
+
+	// measurement without TTL inherits the retention policy
+	msti.TTL = 0
+	buf, err = msti.MarshalBinary()
+	require.NoError(t, err)
+	other = &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, int64(0), other.GetTTL())
+}
+
+func TestMeasurementInfo_CardinalityEstimate(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.Equal(t, uint64(0), msti.EstimatedCardinality)
+
+	msti.SetCardinalityEstimate(123456)
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, uint64(123456), other.EstimatedCardinality)
+	require.Equal(t, uint64(123456), other.clone().EstimatedCardinality)
+}
+
+func TestMeasurementInfo_RenameField(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"host":  {ID: 1, Type: influx.Field_Type_Tag},
+		"usage": {ID: 2, Ref: 3, Type: influx.Field_Type_Float},
+		"count": {ID: 3, Type: influx.Field_Type_Int},
+		"load":  {ID: 4, Type: influx.Field_Type_Float},
+	}
+
+	require.EqualError(t, msti.RenameField("not_exists", "value"), ErrFieldNotFound.Error())
+	// field collides with a tag
+	require.EqualError(t, msti.RenameField("usage", "host"), ErrFieldTypeConflict.Error())
+	// field collides with a field of another type
+	require.EqualError(t, msti.RenameField("usage", "count"), ErrFieldTypeConflict.Error())
+	// field collides with a field of the same type
+	require.EqualError(t, msti.RenameField("usage", "load"), ErrFieldExists.Error())
+
+	require.NoError(t, msti.RenameField("usage", "cpu_usage"))
+	_, ok := msti.Schema["usage"]
+	require.False(t, ok)
+	require.Equal(t, KeyInfo{ID: 2, Ref: 3, Type: influx.Field_Type_Float}, msti.Schema["cpu_usage"])
+	require.Equal(t, "mst", msti.OriginName())
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, msti.Schema, other.Schema)
+}
+
+func TestMeasurementInfo_RenameShardKey(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"az":     {ID: 1, Type: influx.Field_Type_Tag},
+		"host":   {ID: 2, Type: influx.Field_Type_Tag},
+		"region": {ID: 3, Type: influx.Field_Type_Tag},
+		"usage":  {ID: 4, Type: influx.Field_Type_Float},
+	}
+	msti.ShardKeys = []ShardKeyInfo{
+		{ShardKey: []string{"az", "host"}, Type: HASH, ShardGroup: 1},
+		{ShardKey: []string{"region"}, Type: RANGE, ShardGroup: 2},
+	}
+
+	// the renamed tag collides with an existing tag
+	require.EqualError(t, msti.RenameField("az", "region"), ErrFieldExists.Error())
+	require.Equal(t, []string{"az", "host"}, msti.ShardKeys[0].ShardKey)
+
+	require.NoError(t, msti.RenameField("az", "zone"))
+	require.Equal(t, KeyInfo{ID: 1, Type: influx.Field_Type_Tag}, msti.Schema["zone"])
+	// shard keys are kept sorted
+	require.Equal(t, []string{"host", "zone"}, msti.ShardKeys[0].ShardKey)
+	require.Equal(t, []string{"region"}, msti.ShardKeys[1].ShardKey)
+	require.NoError(t, msti.ValidateShardKeys())
+}
+
+func TestMeasurementInfo_RenameIndexColumn(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"host":  {ID: 1, Type: influx.Field_Type_Tag},
+		"usage": {ID: 2, Type: influx.Field_Type_Float},
+		"load":  {ID: 3, Type: influx.Field_Type_Float},
+	}
+	msti.IndexRelation = IndexRelation{
+		Rid:        1,
+		Oids:       []uint32{1, 2},
+		IndexNames: []string{"bloomfilter", "field"},
+		IndexList: []*IndexList{
+			{IList: []string{"usage", "load"}},
+			{IList: []string{"load"}},
+		},
+	}
+	before := msti.GetIndexRelation()
+
+	require.NoError(t, msti.RenameField("usage", "cpu_usage"))
+	require.Equal(t, []string{"cpu_usage", "load"}, msti.IndexRelation.IndexList[0].IList)
+	require.Equal(t, []string{"load"}, msti.IndexRelation.IndexList[1].IList)
+	// the copy taken before the rename is left unchanged
+	require.Equal(t, []string{"usage", "load"}, before.IndexList[0].IList)
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, msti.Schema, other.Schema)
+	require.Equal(t, msti.IndexRelation.IndexList, other.IndexRelation.IndexList)
+}
+
+func TestMeasurementInfo_RenameAliasTarget(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"usage": {ID: 1, Type: influx.Field_Type_Float},
+		"load":  {ID: 2, Type: influx.Field_Type_Float},
+	}
+	msti.Aliases = map[string]string{
+		"cpu":    "usage",
+		"cpu_v1": "usage",
+		"avg":    "load",
+	}
+	before := msti.cloneAliases()
+
+	require.NoError(t, msti.RenameField("usage", "cpu_usage"))
+	require.Equal(t, map[string]string{
+		"cpu":    "cpu_usage",
+		"cpu_v1": "cpu_usage",
+		"avg":    "load",
+	}, msti.Aliases)
+	require.Equal(t, "usage", before["cpu"])
+
+	name, ok := msti.ResolveField("cpu")
+	require.True(t, ok)
+	require.Equal(t, "cpu_usage", name)
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, msti.Schema, other.Schema)
+	require.Equal(t, msti.Aliases, other.Aliases)
+}
+
+func TestMeasurementInfo_AddFieldAndTag(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.NoError(t, msti.AddTag("host"))
+	require.NoError(t, msti.AddField("usage", influx.Field_Type_Float))
+	require.Equal(t, map[string]KeyInfo{
+		"host":  {Type: influx.Field_Type_Tag},
+		"usage": {Type: influx.Field_Type_Float},
+	}, msti.Schema)
+
+	// idempotent inserts keep the existing key info
+	msti.Schema["usage"] = KeyInfo{ID: 2, Ref: 1, Type: influx.Field_Type_Float}
+	require.NoError(t, msti.AddField("usage", influx.Field_Type_Float))
+	require.NoError(t, msti.AddTag("host"))
+	require.Equal(t, KeyInfo{ID: 2, Ref: 1, Type: influx.Field_Type_Float}, msti.Schema["usage"])
+
+	require.EqualError(t, msti.AddField("usage", influx.Field_Type_Int), ErrFieldTypeConflict.Error())
+	require.EqualError(t, msti.AddField("host", influx.Field_Type_String), ErrFieldTypeConflict.Error())
+	require.EqualError(t, msti.AddTag("usage"), ErrFieldTypeConflict.Error())
+	require.Equal(t, 2, len(msti.Schema))
+}
+
+func TestMeasurementInfo_Diff(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"host":  {ID: 1, Type: influx.Field_Type_Tag},
+		"usage": {ID: 2, Type: influx.Field_Type_Float},
+		"count": {ID: 3, Type: influx.Field_Type_Int},
+	}
+	msti.ShardKeys = []ShardKeyInfo{{ShardKey: []string{"host"}, Type: HASH, ShardGroup: 1}}
+	msti.IndexRelation = IndexRelation{Rid: 0, Oids: []uint32{0}, IndexNames: []string{"tsi"}}
+
+	other := msti.clone()
+	diff := msti.Diff(other)
+	require.True(t, diff.Empty())
+
+	// an unmarshalled copy has the same schema
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	decoded := &MeasurementInfo{}
+	require.NoError(t, decoded.UnmarshalBinary(buf))
+	require.True(t, msti.Diff(decoded).Empty())
+
+	delete(other.Schema, "count")
+	other.Schema["count"] = KeyInfo{ID: 3, Type: influx.Field_Type_Tag}
+	other.Schema["load"] = KeyInfo{ID: 4, Type: influx.Field_Type_Float}
+	other.Schema["idle"] = KeyInfo{ID: 5, Type: influx.Field_Type_Float}
+	delete(other.Schema, "usage")
+	other.ShardKeys = append(other.ShardKeys, ShardKeyInfo{ShardKey: []string{"region"}, Type: HASH, ShardGroup: 3})
+	other.IndexRelation.IndexNames = []string{"text"}
+
+	diff = msti.Diff(other)
+	require.False(t, diff.Empty())
+	require.Equal(t, []string{"idle", "load"}, diff.AddedFields)
+	require.Equal(t, []string{"usage"}, diff.RemovedFields)
+	require.Equal(t, []string{"count"}, diff.TypeChangedFields)
+	require.True(t, diff.ShardKeysChanged)
+	require.True(t, diff.IndexRelationChanged)
+}
+
+func TestMeasurementInfo_GetShardKey(t *testing.T) {
+	linear := func(msti *MeasurementInfo, ID uint64) *ShardKeyInfo {
+		for i := len(msti.ShardKeys) - 1; i >= 0; i-- {
+			if msti.ShardKeys[i].ShardGroup <= ID {
+				return &msti.ShardKeys[i]
+			}
+		}
+		return nil
+	}
+
+	msti := NewMeasurementInfo("mst_0000")
+	require.Nil(t, msti.GetShardKey(1))
+
+	for i := 0; i < 100; i++ {
+		// duplicated shard groups are possible after rekeying in one shard group
+		sg := uint64(5 + (i/2)*3)
+		msti.ShardKeys = append(msti.ShardKeys, ShardKeyInfo{
+			ShardKey:   []string{fmt.Sprintf("tag%d", i)},
+			Type:       HASH,
+			ShardGroup: sg,
+		})
+	}
+
+	for id := uint64(0); id < 400; id++ {
+		require.True(t, linear(msti, id) == msti.GetShardKey(id), "shard group %d", id)
+	}
+	require.Nil(t, msti.GetShardKey(4))
+	require.True(t, &msti.ShardKeys[99] == msti.GetShardKey(1000))
+}
+
+func TestMeasurementInfo_UnmarshalBinaryStrict(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"host":  {ID: 1, Type: influx.Field_Type_Tag},
+		"usage": {ID: 2, Type: influx.Field_Type_Float},
+	}
+
+	update := msti.clone()
+	update.Schema["count"] = KeyInfo{ID: 3, Type: influx.Field_Type_Int}
+	buf, err := update.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, msti.UnmarshalBinaryStrict(buf))
+	require.Equal(t, update.Schema, msti.Schema)
+
+	update.Schema["usage"] = KeyInfo{ID: 2, Type: influx.Field_Type_String}
+	buf, err = update.MarshalBinary()
+	require.NoError(t, err)
+	err = msti.UnmarshalBinaryStrict(buf)
+	require.True(t, errors.Is(err, ErrFieldTypeConflict))
+	require.Contains(t, err.Error(), "usage")
+	require.Equal(t, int32(influx.Field_Type_Float), msti.Schema["usage"].Type)
+
+	// the default path stays permissive
+	require.NoError(t, msti.UnmarshalBinary(buf))
+	require.Equal(t, int32(influx.Field_Type_String), msti.Schema["usage"].Type)
+
+	require.NotEmpty(t, msti.UnmarshalBinaryStrict([]byte{1, 2, 3}))
+}
+
+func TestMeasurementInfo_TagKeys(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.Equal(t, []string{}, msti.TagKeys())
+
+	msti.Schema = map[string]KeyInfo{
+		"region": {Type: influx.Field_Type_Tag},
+		"usage":  {Type: influx.Field_Type_Float},
+		"host":   {Type: influx.Field_Type_Tag},
+		"az":     {Type: influx.Field_Type_Tag},
+		"count":  {Type: influx.Field_Type_Int},
+	}
+	require.Equal(t, []string{"az", "host", "region"}, msti.TagKeys())
+
+	ret := map[string]map[string]struct{}{msti.Name: {}}
+	msti.MatchTagKeys(nil, ret)
+	require.Equal(t, len(ret[msti.Name]), len(msti.TagKeys()))
+}
+
+func TestMeasurementInfo_ContainIndexRelation(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.False(t, msti.ContainIndexRelation(0))
+
+	msti.IndexRelation = IndexRelation{
+		Oids:      []uint32{1, 2, 3},
+		IndexList: []*IndexList{{IList: []string{"host"}}, {IList: []string{}}},
+	}
+	require.True(t, msti.ContainIndexRelation(1))
+	// index without any column
+	require.False(t, msti.ContainIndexRelation(2))
+	// index without column list
+	require.False(t, msti.ContainIndexRelation(3))
+	require.False(t, msti.ContainIndexRelation(4))
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.True(t, other.ContainIndexRelation(1))
+	require.False(t, other.ContainIndexRelation(2))
+}
+
+func TestMeasurementInfo_DropField(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"host":   {Type: influx.Field_Type_Tag},
+		"region": {Type: influx.Field_Type_Tag},
+		"usage":  {Type: influx.Field_Type_Float},
+		"count":  {Type: influx.Field_Type_Int},
+	}
+	msti.ShardKeys = []ShardKeyInfo{
+		{ShardKey: []string{"az"}, Type: HASH, ShardGroup: 1},
+		{ShardKey: []string{"host"}, Type: HASH, ShardGroup: 5},
+	}
+
+	dropped, err := msti.DropField("time")
+	require.EqualError(t, err, ErrDropTimeField.Error())
+	require.False(t, dropped)
+
+	dropped, err = msti.DropField("host")
+	require.EqualError(t, err, ErrDropShardKey.Error())
+	require.False(t, dropped)
+	_, ok := msti.Schema["host"]
+	require.True(t, ok)
+
+	dropped, err = msti.DropField("not_exists")
+	require.NoError(t, err)
+	require.False(t, dropped)
+
+	dropped, err = msti.DropField("usage")
+	require.NoError(t, err)
+	require.True(t, dropped)
+
+	dropped, err = msti.DropField("region")
+	require.NoError(t, err)
+	require.True(t, dropped)
+
+	require.Equal(t, map[string]KeyInfo{
+		"host":  {Type: influx.Field_Type_Tag},
+		"count": {Type: influx.Field_Type_Int},
+	}, msti.Schema)
+}
+
+func TestMeasurementInfo_MatchTagKeysCache(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"host":   {Type: influx.Field_Type_Tag},
+		"region": {Type: influx.Field_Type_Tag},
+		"usage":  {Type: influx.Field_Type_Float},
+	}
+	msti.SchemaChanged()
+
+	cond, err := influxql.ParseExpr(`_tagKey != 'host'`)
+	require.NoError(t, err)
+
+	match := func(m *MeasurementInfo, cond influxql.Expr) map[string]struct{} {
+		ret := map[string]map[string]struct{}{m.Name: {}}
+		m.MatchTagKeys(cond, ret)
+		return ret[m.Name]
+	}
+	matchNoCache := func(m *MeasurementInfo, cond influxql.Expr) map[string]struct{} {
+		ret := map[string]map[string]struct{}{m.Name: {}}
+		m.MatchTagKeysNoCache(cond, ret)
+		return ret[m.Name]
+	}
+
+	require.Equal(t, map[string]struct{}{"host": {}, "region": {}}, match(msti, nil))
+	require.Equal(t, map[string]struct{}{"region": {}}, match(msti, cond))
+	require.Equal(t, 2, len(msti.tagKeysCache.items))
+	// served from the cache
+	require.Equal(t, map[string]struct{}{"region": {}}, match(msti, cond))
+
+	require.NoError(t, msti.AddTag("az"))
+	require.Equal(t, map[string]struct{}{"az": {}, "region": {}}, match(msti, cond))
+	require.Equal(t, 1, len(msti.tagKeysCache.items))
+
+	// direct schema mutations are not tracked unless SchemaChanged is called
+	msti.Schema["rack"] = KeyInfo{Type: influx.Field_Type_Tag}
+	require.Equal(t, map[string]struct{}{"az": {}, "region": {}}, match(msti, cond))
+	require.Equal(t, map[string]struct{}{"az": {}, "rack": {}, "region": {}}, matchNoCache(msti, cond))
+	msti.SchemaChanged()
+	require.Equal(t, map[string]struct{}{"az": {}, "rack": {}, "region": {}}, match(msti, cond))
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.NoError(t, other.RenameField("usage", "cpu"))
+	_, err = other.DropField("rack")
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"az": {}, "region": {}}, match(other, cond))
+
+	// measurement without cache
+	msti = &MeasurementInfo{Name: "mst_0000", Schema: map[string]KeyInfo{"host": {Type: influx.Field_Type_Tag}}}
+	require.Equal(t, map[string]struct{}{"host": {}}, match(msti, nil))
+}
+
+func TestMeasurementInfo_ValidateShardKeys(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.NoError(t, msti.ValidateShardKeys())
+
+	msti.Schema = map[string]KeyInfo{
+		"host":   {Type: influx.Field_Type_Tag},
+		"region": {Type: influx.Field_Type_Tag},
+		"usage":  {Type: influx.Field_Type_Float},
+	}
+	msti.ShardKeys = []ShardKeyInfo{
+		{ShardKey: []string{"host", "region"}, Type: HASH, ShardGroup: 1},
+		{ShardKey: []string{"region"}, Type: RANGE, ShardGroup: 2},
+	}
+	require.NoError(t, msti.ValidateShardKeys())
+
+	msti.ShardKeys = append(msti.ShardKeys,
+		ShardKeyInfo{ShardKey: []string{"hots", "usage"}, Type: HASH, ShardGroup: 3},
+		ShardKeyInfo{ShardKey: []string{"host"}, Type: "round-robin", ShardGroup: 4},
+	)
+	err := msti.ValidateShardKeys()
+	require.True(t, errors.Is(err, ErrInvalidShardKey))
+	require.Contains(t, err.Error(), "[hots usage]")
+	require.Contains(t, err.Error(), `["round-robin"]`)
+}
+
+func TestMeasurementInfo_DropFieldAndIndex(t *testing.T) {
+	newMsti := func() *MeasurementInfo {
+		msti := NewMeasurementInfo("mst_0000")
+		msti.Schema = map[string]KeyInfo{
+			"host":  {Type: influx.Field_Type_Tag},
+			"msg":   {Type: influx.Field_Type_String},
+			"level": {Type: influx.Field_Type_String},
+			"usage": {Type: influx.Field_Type_Float},
+		}
+		msti.IndexRelation = IndexRelation{
+			Oids:       []uint32{1, 2},
+			IndexNames: []string{"text", "field"},
+			IndexList:  []*IndexList{{IList: []string{"msg", "level"}}, {IList: []string{"msg"}}},
+		}
+		return msti
+	}
+
+	msti := newMsti()
+	require.EqualError(t, msti.DropFieldAndIndex("not_exists", false), ErrFieldNotFound.Error())
+	require.EqualError(t, msti.DropFieldAndIndex("time", false), ErrDropTimeField.Error())
+
+	// the field index would be left empty
+	require.EqualError(t, msti.DropFieldAndIndex("msg", false), ErrIndexEmpty.Error())
+	require.Equal(t, newMsti().Schema, msti.Schema)
+	require.Equal(t, newMsti().IndexRelation, msti.IndexRelation)
+
+	require.NoError(t, msti.DropFieldAndIndex("usage", false))
+	require.NoError(t, msti.DropFieldAndIndex("level", false))
+	require.Equal(t, []string{"msg"}, msti.IndexRelation.IndexList[0].IList)
+
+	require.NoError(t, msti.DropFieldAndIndex("msg", true))
+	require.Equal(t, map[string]KeyInfo{"host": {Type: influx.Field_Type_Tag}}, msti.Schema)
+	require.Equal(t, IndexRelation{
+		Oids:       []uint32{},
+		IndexNames: []string{},
+		IndexList:  []*IndexList{},
+	}, msti.IndexRelation)
+
+	msti = newMsti()
+	require.NoError(t, msti.DropFieldAndIndex("level", false))
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, msti.Schema, other.Schema)
+	require.Equal(t, [][]string{{"msg"}, {"msg"}},
+		[][]string{other.IndexRelation.IndexList[0].IList, other.IndexRelation.IndexList[1].IList})
+}
+
+func TestMeasurementInfo_TagKeysAndFieldKeysOnly(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.Equal(t, map[string]int32{}, msti.FieldKeysOnly())
+
+	msti.Schema = map[string]KeyInfo{
+		"host":   {Type: influx.Field_Type_Tag},
+		"region": {Type: influx.Field_Type_Tag},
+		"usage":  {Type: influx.Field_Type_Float},
+		"count":  {Type: influx.Field_Type_Int},
+		"msg":    {Type: influx.Field_Type_String},
+		"alive":  {Type: influx.Field_Type_Boolean},
+	}
+
+	tags := msti.TagKeys()
+	fields := msti.FieldKeysOnly()
+	require.Equal(t, []string{"host", "region"}, tags)
+	require.Equal(t, map[string]int32{
+		"usage": influx.Field_Type_Float,
+		"count": influx.Field_Type_Int,
+		"msg":   influx.Field_Type_String,
+		"alive": influx.Field_Type_Boolean,
+	}, fields)
+	require.Equal(t, len(msti.Schema), len(tags)+len(fields))
+
+	ret := map[string]map[string]int32{"mst": {}}
+	msti.FieldKeys(ret)
+	require.Equal(t, fields, ret["mst"])
+}
+
+func TestMeasurementInfo_FieldCounts(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.Equal(t, map[int32]int{}, msti.FieldCounts())
+
+	msti.Schema = map[string]KeyInfo{
+		"host":   {Type: influx.Field_Type_Tag},
+		"region": {Type: influx.Field_Type_Tag},
+		"usage":  {Type: influx.Field_Type_Float},
+		"load":   {Type: influx.Field_Type_Float},
+		"count":  {Type: influx.Field_Type_Int},
+		"msg":    {Type: influx.Field_Type_String},
+		"alive":  {Type: influx.Field_Type_Boolean},
+	}
+	require.Equal(t, map[int32]int{
+		influx.Field_Type_Tag:     2,
+		influx.Field_Type_Float:   2,
+		influx.Field_Type_Int:     1,
+		influx.Field_Type_String:  1,
+		influx.Field_Type_Boolean: 1,
+	}, msti.FieldCounts())
+}
+
+func TestMeasurementInfo_FindMstInfos(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"host":  {Type: influx.Field_Type_Tag},
+		"usage": {Type: influx.Field_Type_Float},
+		"load":  {Type: influx.Field_Type_Float},
+		"count": {Type: influx.Field_Type_Int},
+		"alive": {Type: influx.Field_Type_Boolean},
+	}
+
+	infos := msti.FindMstInfos([]int64{int64(influxql.String), int64(influxql.Boolean), int64(influxql.Tag),
+		int64(influxql.Float), int64(influxql.Integer)})
+	require.Equal(t, 3, len(infos))
+	// the output keeps the order of the requested types, empty types are omitted
+	require.Equal(t, &MeasurementTypeFields{Type: int64(influxql.Boolean), Fields: []string{"alive"}}, infos[0])
+	require.Equal(t, &MeasurementTypeFields{Type: int64(influxql.Float), Fields: []string{"load", "usage"}}, infos[1])
+	require.Equal(t, &MeasurementTypeFields{Type: int64(influxql.Integer), Fields: []string{"count"}}, infos[2])
+
+	require.Equal(t, 0, len(msti.FindMstInfos([]int64{int64(influxql.String)})))
+}
+
+func TestMeasurementInfo_FieldTypeCounts(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.Empty(t, msti.FieldTypeCounts())
+
+	msti.Schema = map[string]KeyInfo{
+		"host":   {Type: influx.Field_Type_Tag},
+		"region": {Type: influx.Field_Type_Tag},
+		"usage":  {Type: influx.Field_Type_Float},
+		"load":   {Type: influx.Field_Type_Float},
+		"idle":   {Type: influx.Field_Type_Float},
+		"count":  {Type: influx.Field_Type_Int},
+		"msg":    {Type: influx.Field_Type_String},
+		"alive":  {Type: influx.Field_Type_Boolean},
+	}
+	require.Equal(t, map[int32]int{
+		influx.Field_Type_Tag:     2,
+		influx.Field_Type_Float:   3,
+		influx.Field_Type_Int:     1,
+		influx.Field_Type_String:  1,
+		influx.Field_Type_Boolean: 1,
+	}, msti.FieldTypeCounts())
+	require.Equal(t, msti.FieldCounts(), msti.FieldTypeCounts())
+}
+
+func TestMeasurementInfo_WalkSchemaSorted(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.WalkSchemaSorted(func(fieldName string, fieldType int32) {
+		t.Fatalf("unexpected field %s", fieldName)
+	})
+
+	msti.Schema = map[string]KeyInfo{
+		"usage": {Type: influx.Field_Type_Float},
+		"host":  {Type: influx.Field_Type_Tag},
+		"load":  {Type: influx.Field_Type_Float},
+		"count": {Type: influx.Field_Type_Int},
+		"alive": {Type: influx.Field_Type_Boolean},
+	}
+
+	for i := 0; i < 10; i++ {
+		var names []string
+		var types []int32
+		msti.WalkSchemaSorted(func(fieldName string, fieldType int32) {
+			names = append(names, fieldName)
+			types = append(types, fieldType)
+		})
+		require.Equal(t, []string{"alive", "count", "host", "load", "usage"}, names)
+		require.Equal(t, []int32{influx.Field_Type_Boolean, influx.Field_Type_Int, influx.Field_Type_Tag,
+			influx.Field_Type_Float, influx.Field_Type_Float}, types)
+	}
+}
+
+func TestMeasurementInfo_Equal(t *testing.T) {
+	newMst := func() *MeasurementInfo {
+		msti := NewMeasurementInfo("mst_0000")
+		msti.Schema = map[string]KeyInfo{
+			"host":  {ID: 1, Type: influx.Field_Type_Tag},
+			"usage": {ID: 2, Type: influx.Field_Type_Float},
+		}
+		msti.ShardKeys = []ShardKeyInfo{{ShardKey: []string{"host"}, Type: HASH, ShardGroup: 1}}
+		msti.IndexRelation = IndexRelation{
+			Rid:        1,
+			Oids:       []uint32{1},
+			IndexNames: []string{"idx"},
+			IndexList:  []*IndexList{{IList: []string{"host"}}},
+		}
+		return msti
+	}
+
+	tests := []struct {
+		name   string
+		modify func(msti *MeasurementInfo)
+		equal  bool
+	}{
+		{name: "same", modify: func(msti *MeasurementInfo) {}, equal: true},
+		{name: "name", modify: func(msti *MeasurementInfo) { msti.Name = "mst_0001" }},
+		{name: "mark deleted", modify: func(msti *MeasurementInfo) { msti.MarkDeleted = true }},
+		{name: "field added", modify: func(msti *MeasurementInfo) { msti.Schema["load"] = KeyInfo{Type: influx.Field_Type_Float} }},
+		{name: "field removed", modify: func(msti *MeasurementInfo) { delete(msti.Schema, "usage") }},
+		{name: "field type", modify: func(msti *MeasurementInfo) { msti.Schema["usage"] = KeyInfo{ID: 2, Type: influx.Field_Type_Int} }},
+		{name: "field ref", modify: func(msti *MeasurementInfo) {
+			msti.Schema["usage"] = KeyInfo{ID: 2, Ref: 1, Type: influx.Field_Type_Float}
+		}},
+		{name: "shard key", modify: func(msti *MeasurementInfo) { msti.ShardKeys[0].ShardKey = []string{"usage"} }},
+		{name: "shard key type", modify: func(msti *MeasurementInfo) { msti.ShardKeys[0].Type = RANGE }},
+		{name: "shard group", modify: func(msti *MeasurementInfo) { msti.ShardKeys[0].ShardGroup = 2 }},
+		{name: "no shard keys", modify: func(msti *MeasurementInfo) { msti.ShardKeys = nil }},
+		{name: "index rid", modify: func(msti *MeasurementInfo) { msti.IndexRelation.Rid = 2 }},
+		{name: "index oids", modify: func(msti *MeasurementInfo) { msti.IndexRelation.Oids = []uint32{2} }},
+		{name: "index names", modify: func(msti *MeasurementInfo) { msti.IndexRelation.IndexNames = nil }},
+		{name: "index list", modify: func(msti *MeasurementInfo) { msti.IndexRelation.IndexList[0] = nil }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msti, other := newMst(), newMst()
+			tt.modify(other)
+			require.Equal(t, tt.equal, msti.Equal(other))
+			require.Equal(t, tt.equal, other.Equal(msti))
+		})
+	}
+
+	// nil and empty maps/slices are equal
+	msti, other := NewMeasurementInfo("mst_0000"), NewMeasurementInfo("mst_0000")
+	other.Schema = map[string]KeyInfo{}
+	other.ShardKeys = []ShardKeyInfo{}
+	other.IndexRelation = IndexRelation{Oids: []uint32{}, IndexNames: []string{}, IndexList: []*IndexList{}}
+	require.True(t, msti.Equal(other))
+	require.True(t, msti.Equal(msti.clone()))
+	require.False(t, msti.Equal(nil))
+}
+
+func TestMeasurementInfo_UpdatedAt(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.Equal(t, int64(0), msti.UpdatedAt)
+
+	require.NoError(t, msti.AddField("usage", influx.Field_Type_Float))
+	added := msti.UpdatedAt
+	require.NotEqual(t, int64(0), added)
+
+	// adding an existing field does not change the schema
+	require.NoError(t, msti.AddField("usage", influx.Field_Type_Float))
+	require.Equal(t, added, msti.UpdatedAt)
+
+	msti.UpdatedAt = 1
+	require.NoError(t, msti.RenameField("usage", "cpu_usage"))
+	require.Greater(t, msti.UpdatedAt, int64(1))
+
+	msti.UpdatedAt = 1
+	_, err := msti.DropField("cpu_usage")
+	require.NoError(t, err)
+	require.Greater(t, msti.UpdatedAt, int64(1))
+
+	msti.Touch(2)
+	require.Equal(t, int64(2), msti.UpdatedAt)
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, msti.UpdatedAt, other.UpdatedAt)
+	require.Equal(t, msti.UpdatedAt, other.clone().UpdatedAt)
+}
+
+func TestShardKeyInfo_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		ski  ShardKeyInfo
+		err  error
+	}{
+		{name: "hash", ski: ShardKeyInfo{ShardKey: []string{"az", "host"}, Type: HASH}},
+		{name: "range", ski: ShardKeyInfo{ShardKey: []string{"host"}, Type: RANGE}},
+		{name: "unsorted", ski: ShardKeyInfo{ShardKey: []string{"host", "az"}, Type: HASH}, err: ErrInvalidShardKey},
+		{name: "duplicate", ski: ShardKeyInfo{ShardKey: []string{"az", "az"}, Type: HASH}, err: ErrDuplicateShardKey},
+		{name: "empty", ski: ShardKeyInfo{Type: HASH}, err: ErrShardKeyRequired},
+		{name: "unknown type", ski: ShardKeyInfo{ShardKey: []string{"host"}, Type: "list"}, err: ErrInvalidShardKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ski.Validate()
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, errors.Is(err, tt.err), err)
+		})
+	}
+}
+
+func TestMeasurementInfo_SetShardKey(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.True(t, errors.Is(msti.SetShardKey(ShardKeyInfo{ShardKey: []string{"host"}, Type: "list"}), ErrInvalidShardKey))
+	require.Equal(t, 0, len(msti.ShardKeys))
+
+	require.NoError(t, msti.SetShardKey(ShardKeyInfo{ShardKey: []string{"host"}, Type: HASH, ShardGroup: 5}))
+	require.NoError(t, msti.SetShardKey(ShardKeyInfo{ShardKey: []string{"az"}, Type: HASH, ShardGroup: 1}))
+	require.NoError(t, msti.SetShardKey(ShardKeyInfo{ShardKey: []string{"region"}, Type: HASH, ShardGroup: 9}))
+	// replace the shard key of shard group 5
+	keys := []string{"az", "host"}
+	require.NoError(t, msti.SetShardKey(ShardKeyInfo{ShardKey: keys, Type: HASH, ShardGroup: 5}))
+	keys[0] = "changed"
+
+	require.Equal(t, []ShardKeyInfo{
+		{ShardKey: []string{"az"}, Type: HASH, ShardGroup: 1},
+		{ShardKey: []string{"az", "host"}, Type: HASH, ShardGroup: 5},
+		{ShardKey: []string{"region"}, Type: HASH, ShardGroup: 9},
+	}, msti.ShardKeys)
+	require.Equal(t, []string{"az", "host"}, msti.GetShardKey(6).ShardKey)
+}
+
+func TestMeasurementInfo_Undelete(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.MarkDeleted = true
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.True(t, other.MarkDeleted)
+	require.True(t, other.clone().MarkDeleted)
+
+	removed := true
+	require.EqualError(t, other.Undelete(func() bool { return removed }), ErrMeasurementRemoved.Error())
+	require.True(t, other.MarkDeleted)
+
+	removed = false
+	require.NoError(t, other.Undelete(func() bool { return removed }))
+	require.False(t, other.MarkDeleted)
+
+	// undelete a measurement which is not deleted is a no-op
+	require.NoError(t, other.Undelete(nil))
+	require.False(t, other.MarkDeleted)
+
+	require.NoError(t, msti.Undelete(nil))
+	require.False(t, msti.MarkDeleted)
+}
+
+func TestIndexRelation_AddAndRemoveIndex(t *testing.T) {
+	indexR := &IndexRelation{}
+	require.NoError(t, indexR.AddIndex(1, "idx_host", []string{"host"}))
+	require.NoError(t, indexR.AddIndex(2, "idx_az", []string{"az", "region"}))
+	require.NoError(t, indexR.AddIndex(1, "idx_ip", []string{"ip"}))
+	require.EqualError(t, indexR.AddIndex(3, "idx_az", []string{"az"}), ErrIndexExists.Error())
+	require.EqualError(t, indexR.AddIndex(3, "idx_empty", nil), ErrIndexEmpty.Error())
+
+	require.Equal(t, []uint32{1, 2, 1}, indexR.Oids)
+	require.Equal(t, []string{"idx_host", "idx_az", "idx_ip"}, indexR.IndexNames)
+	require.Equal(t, []*IndexList{{IList: []string{"host"}}, {IList: []string{"az", "region"}}, {IList: []string{"ip"}}},
+		indexR.IndexList)
+
+	require.False(t, indexR.RemoveIndex("not_exists"))
+	require.True(t, indexR.RemoveIndex("idx_az"))
+	require.False(t, indexR.RemoveIndex("idx_az"))
+	require.Equal(t, []uint32{1, 1}, indexR.Oids)
+	require.Equal(t, []string{"idx_host", "idx_ip"}, indexR.IndexNames)
+	require.Equal(t, []*IndexList{{IList: []string{"host"}}, {IList: []string{"ip"}}}, indexR.IndexList)
+
+	require.NoError(t, indexR.AddIndex(2, "idx_az", []string{"az"}))
+	require.True(t, indexR.RemoveIndex("idx_host"))
+	require.Equal(t, []uint32{1, 2}, indexR.Oids)
+	require.Equal(t, []string{"idx_ip", "idx_az"}, indexR.IndexNames)
+	require.Equal(t, []*IndexList{{IList: []string{"ip"}}, {IList: []string{"az"}}}, indexR.IndexList)
+
+	// relations built without index names can not be extended safely
+	indexR = &IndexRelation{Oids: []uint32{1}, IndexList: []*IndexList{{IList: []string{"host"}}}}
+	require.EqualError(t, indexR.AddIndex(2, "idx_az", []string{"az"}), ErrIndexRelationInconsistent.Error())
+}
+
+func TestMeasurementInfo_JSON(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"host":  {ID: 1, Ref: 2, Type: influx.Field_Type_Tag},
+		"usage": {ID: 2, Type: influx.Field_Type_Float},
+		"count": {ID: 3, Type: influx.Field_Type_Int},
+		"msg":   {ID: 4, Type: influx.Field_Type_String},
+		"alive": {ID: 5, Type: influx.Field_Type_Boolean},
+	}
+	msti.ShardKeys = []ShardKeyInfo{{ShardKey: []string{"host"}, Type: HASH, ShardGroup: 1}}
+	require.NoError(t, msti.IndexRelation.AddIndex(1, "idx_host", []string{"host"}))
+
+	buf, err := json.Marshal(msti)
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `"host":{"ID":1,"Ref":2,"Type":"tag"}`)
+	require.Contains(t, string(buf), `"usage":{"ID":2,"Ref":0,"Type":"float"}`)
+
+	fromJSON := &MeasurementInfo{}
+	require.NoError(t, json.Unmarshal(buf, fromJSON))
+	require.Equal(t, "mst", fromJSON.OriginName())
+
+	bin, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	fromBinary := &MeasurementInfo{}
+	require.NoError(t, fromBinary.UnmarshalBinary(bin))
+	require.True(t, fromJSON.Equal(fromBinary))
+
+	msti.Schema["bad"] = KeyInfo{Type: influx.Field_Type_Unknown}
+	_, err = json.Marshal(msti)
+	require.Error(t, err)
+	require.Error(t, fromJSON.UnmarshalJSON([]byte(`{"Name":"mst_0000","Schema":{"bad":{"Type":"decimal"}}}`)))
+}
+
+func TestMeasurementInfo_JSONStableOrder(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	msti.Schema = map[string]KeyInfo{
+		"usage":  {ID: 3, Type: influx.Field_Type_Float},
+		"region": {ID: 2, Type: influx.Field_Type_Tag},
+		"alive":  {ID: 4, Type: influx.Field_Type_Boolean},
+		"host":   {ID: 1, Type: influx.Field_Type_Tag},
+	}
+	msti.ShardKeys = []ShardKeyInfo{{ShardKey: []string{"host", "region"}, Type: HASH, ShardGroup: 1}}
+	require.NoError(t, msti.IndexRelation.AddIndex(1, "idx_host", []string{"host"}))
+
+	exp := `{"Name":"mst_0000",` +
+		`"Schema":{"alive":{"ID":4,"Ref":0,"Type":"boolean"},"host":{"ID":1,"Ref":0,"Type":"tag"},` +
+		`"region":{"ID":2,"Ref":0,"Type":"tag"},"usage":{"ID":3,"Ref":0,"Type":"float"}},` +
+		`"ShardKeys":[{"ShardKey":["host","region"],"Type":"hash","ShardGroup":1}],` +
+		`"IndexRelation":{"Rid":0,"Oids":[1],"IndexNames":["idx_host"],"IndexList":[{"IList":["host"]}]}}`
+	for i := 0; i < 10; i++ {
+		buf, err := json.Marshal(msti)
+		require.NoError(t, err)
+		require.Equal(t, exp, string(buf))
+	}
+
+	// the first invalid field in lexical order is reported
+	msti.Schema["bad_b"] = KeyInfo{Type: influx.Field_Type_Unknown}
+	msti.Schema["bad_a"] = KeyInfo{Type: influx.Field_Type_Unknown}
+	for i := 0; i < 10; i++ {
+		_, err := msti.MarshalJSON()
+		require.EqualError(t, err, fmt.Sprintf("unknown type %d of field bad_a", influx.Field_Type_Unknown))
+	}
+}
+
+func TestMeasurementInfo_RetentionOverride(t *testing.T) {
+	dbDefault := int64(7 * 24 * time.Hour)
+	msti := NewMeasurementInfo("mst_0000")
+	require.Equal(t, dbDefault, msti.EffectiveRetention(dbDefault))
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Nil(t, other.RetentionOverride)
+	require.Equal(t, dbDefault, other.EffectiveRetention(dbDefault))
+
+	msti.TTL = int64(3 * 24 * time.Hour)
+	require.Equal(t, msti.TTL, msti.EffectiveRetention(dbDefault))
+
+	override := int64(time.Hour)
+	msti.RetentionOverride = &override
+	require.Equal(t, override, msti.EffectiveRetention(dbDefault))
+	// zero override keeps the data forever instead of inheriting
+	zero := int64(0)
+	cloned := msti.clone()
+	cloned.RetentionOverride = &zero
+	require.Equal(t, int64(0), cloned.EffectiveRetention(dbDefault))
+	require.Equal(t, override, *msti.RetentionOverride)
+	require.False(t, msti.Equal(cloned))
+
+	buf, err = msti.MarshalBinary()
+	require.NoError(t, err)
+	other = &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, override, *other.RetentionOverride)
+	require.True(t, msti.Equal(other))
+}
+
+func TestMeasurementInfo_Aliases(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.NoError(t, msti.AddTag("host"))
+	require.NoError(t, msti.AddField("usage", influx.Field_Type_Float))
+
+	name, ok := msti.ResolveField("usage")
+	require.True(t, ok)
+	require.Equal(t, "usage", name)
+	_, ok = msti.ResolveField("cpu_usage")
+	require.False(t, ok)
+
+	msti.Aliases = map[string]string{"cpu_usage": "usage", "hostname": "host"}
+	name, ok = msti.ResolveField("cpu_usage")
+	require.True(t, ok)
+	require.Equal(t, "usage", name)
+	name, ok = msti.ResolveField("hostname")
+	require.True(t, ok)
+	require.Equal(t, "host", name)
+	_, ok = msti.ResolveField("load")
+	require.False(t, ok)
+
+	// the schema takes precedence over the aliases
+	msti.Aliases["usage"] = "host"
+	name, ok = msti.ResolveField("usage")
+	require.True(t, ok)
+	require.Equal(t, "usage", name)
+
+	cloned := msti.clone()
+	require.True(t, msti.Equal(cloned))
+	cloned.Aliases["cpu_usage"] = "host"
+	require.Equal(t, "usage", msti.Aliases["cpu_usage"])
+	require.False(t, msti.Equal(cloned))
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{Aliases: map[string]string{"old": "usage"}}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, msti.Aliases, other.Aliases)
+	require.True(t, msti.Equal(other))
+
+	msti.Aliases = nil
+	buf, err = msti.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Nil(t, other.Aliases)
+	require.Nil(t, msti.clone().Aliases)
+}
+
+func TestMeasurementInfo_SchemaLock(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			require.NoError(t, msti.AddField(fmt.Sprintf("f%d", i), influx.Field_Type_Float))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_, err := msti.MarshalBinary()
+			require.NoError(t, err)
+			_, err = msti.MarshalJSON()
+			require.NoError(t, err)
+		}
+	}()
+	wg.Wait()
+
+	msti.RLockSchema()
+	require.Equal(t, 100, len(msti.Schema))
+	msti.RUnlockSchema()
+
+	// measurements built without NewMeasurementInfo fall back to the shared lock
+	other := &MeasurementInfo{Name: "mst_0000"}
+	require.NoError(t, other.AddTag("t1"))
+	other.RLockSchema()
+	require.Equal(t, int32(influx.Field_Type_Tag), other.Schema["t1"].Type)
+	other.RUnlockSchema()
+}
+
+func TestMeasurementInfo_SchemaLockReaders(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.NoError(t, msti.AddTag("host"))
+	require.NoError(t, msti.SetShardKey(ShardKeyInfo{ShardKey: []string{"host"}, Type: HASH}))
+	require.NoError(t, msti.IndexRelation.AddIndex(1, "idx", []string{"host", "f0"}))
+	require.NoError(t, msti.AddField("f0", influx.Field_Type_Float))
+	other := msti.clone()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 1; i < 100; i++ {
+			require.NoError(t, msti.AddField(fmt.Sprintf("f%d", i), influx.Field_Type_Float))
+			require.NoError(t, msti.RenameField(fmt.Sprintf("f%d", i), fmt.Sprintf("g%d", i)))
+		}
+		require.NoError(t, msti.DropFieldAndIndex("f0", true))
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			msti.FieldKeys(map[string]map[string]int32{"mst": {}})
+			msti.TagKeys()
+			msti.FieldCounts()
+			msti.MatchTagKeys(nil, map[string]map[string]struct{}{"mst_0000": {}})
+			msti.ResolveField("f0")
+			msti.FindMstInfos([]int64{int64(influxql.Float)})
+			msti.CoercibleTypes("f0")
+			msti.ContainIndexRelation(1)
+			msti.GetIndexRelation()
+			msti.Equal(other)
+			msti.Diff(other)
+			require.NoError(t, msti.ValidateShardKeys())
+		}
+	}()
+	wg.Wait()
+
+	require.Equal(t, 99, len(msti.FieldKeysOnly()))
+	require.Equal(t, []string{"host"}, msti.GetIndexRelation().IndexList[0].IList)
+	// the copy taken before the drop keeps its index list
+	require.Equal(t, []string{"host", "f0"}, other.GetIndexRelation().IndexList[0].IList)
+}
+
+func TestMeasurementInfo_MarkDeletedHook(t *testing.T) {
+	type event struct {
+		name     string
+		old, new bool
+	}
+	var events []event
+	SetOnMarkDeleted(func(name string, old, new bool) {
+		events = append(events, event{name, old, new})
+	})
+	defer SetOnMarkDeleted(nil)
+
+	msti := NewMeasurementInfo("mst_0000")
+	msti.SetMarkDeleted(true)
+	msti.SetMarkDeleted(true)
+	require.NoError(t, msti.Undelete(nil))
+	require.NoError(t, msti.Undelete(nil))
+	require.Equal(t, []event{{"mst_0000", false, true}, {"mst_0000", true, false}}, events)
+
+	// decoding a snapshot is not reported
+	deleted := msti.clone()
+	deleted.MarkDeleted = true
+	buf, err := deleted.MarshalBinary()
+	require.NoError(t, err)
+
+	events = events[:0]
+	require.NoError(t, msti.UnmarshalBinary(buf))
+	require.True(t, msti.MarkDeleted)
+	require.Empty(t, events)
+
+	// the apply path of Data reports the transition
+	data := initData()
+	require.NoError(t, generateMeasurement(data, "foo", "bar", "cpu"))
+	require.NoError(t, data.MarkMeasurementDelete("foo", "bar", "cpu"))
+	require.Equal(t, []event{{"cpu_0000", false, true}}, events)
+
+	SetOnMarkDeleted(nil)
+	msti.SetMarkDeleted(false)
+	require.Equal(t, 1, len(events))
+}
+
+func TestMeasurementInfo_CoercibleTypes(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.NoError(t, msti.AddTag("host"))
+	require.NoError(t, msti.AddField("i", influx.Field_Type_Int))
+	require.NoError(t, msti.AddField("u", influx.Field_Type_UInt))
+	require.NoError(t, msti.AddField("f", influx.Field_Type_Float))
+	require.NoError(t, msti.AddField("s", influx.Field_Type_String))
+	require.NoError(t, msti.AddField("b", influx.Field_Type_Boolean))
+
+	require.Equal(t, []int32{influx.Field_Type_Int, influx.Field_Type_Float}, msti.CoercibleTypes("i"))
+	require.Equal(t, []int32{influx.Field_Type_UInt, influx.Field_Type_Float}, msti.CoercibleTypes("u"))
+	require.Equal(t, []int32{influx.Field_Type_Float}, msti.CoercibleTypes("f"))
+	for _, name := range []string{"host", "s", "b", "not_exists"} {
+		require.Nil(t, msti.CoercibleTypes(name), name)
+	}
+
+	// the result is owned by the caller
+	types := msti.CoercibleTypes("i")
+	types[0] = influx.Field_Type_String
+	require.Equal(t, []int32{influx.Field_Type_Int, influx.Field_Type_Float}, msti.CoercibleTypes("i"))
+}
+
+func TestMeasurementInfo_DownSamplePolicies(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	require.NoError(t, msti.AddTag("host"))
+	require.NoError(t, msti.AddField("f1", influx.Field_Type_Float))
+	require.NoError(t, msti.AddField("i1", influx.Field_Type_Int))
+
+	policy := MeasurementDownSamplePolicy{
+		Interval:        time.Hour,
+		Calls:           map[string][]string{"f1": {"mean", "max"}, "i1": {"sum"}},
+		DestMeasurement: "mst_1h",
+	}
+	require.NoError(t, msti.AddDownSamplePolicy(policy))
+	require.True(t, errors.Is(msti.AddDownSamplePolicy(policy), ErrDownSamplePolicyExists))
+
+	invalid := []MeasurementDownSamplePolicy{
+		{Calls: map[string][]string{"f1": {"mean"}}, DestMeasurement: "mst_0"},
+		{Interval: time.Minute, Calls: map[string][]string{"f1": {"mean"}}},
+		{Interval: time.Minute, DestMeasurement: "mst_1m"},
+		{Interval: time.Minute, Calls: map[string][]string{"host": {"count"}}, DestMeasurement: "mst_1m"},
+		{Interval: time.Minute, Calls: map[string][]string{"f1": {}}, DestMeasurement: "mst_1m"},
+		{Interval: time.Minute, Calls: map[string][]string{"f1": {"median"}}, DestMeasurement: "mst_1m"},
+	}
+	for i := range invalid {
+		require.Error(t, msti.AddDownSamplePolicy(invalid[i]), "policy %d", i)
+	}
+
+	// the stored policy and the listed ones are not shared with the caller
+	policy.Calls["f1"][0] = "min"
+	policies := msti.ListDownSamplePolicies()
+	require.Len(t, policies, 1)
+	require.Equal(t, []string{"mean", "max"}, policies[0].Calls["f1"])
+	policies[0].Calls["i1"] = nil
+	require.Equal(t, []string{"sum"}, msti.ListDownSamplePolicies()[0].Calls["i1"])
+
+	buf, err := msti.MarshalBinary()
+	require.NoError(t, err)
+	other := &MeasurementInfo{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	require.Equal(t, msti.ListDownSamplePolicies(), other.ListDownSamplePolicies())
+	require.True(t, msti.Equal(other))
+
+	cloned := msti.clone()
+	require.True(t, msti.Equal(cloned))
+	cloned.DownSamplePolicies[0].Calls["f1"][1] = "min"
+	require.False(t, msti.Equal(cloned))
+}
+
+func TestMeasurementInfo_KeyRefs(t *testing.T) {
+	msti := NewMeasurementInfo("mst_0000")
+	id := uint64(10)
+	nextID := func() uint64 {
+		id++
+		return id
+	}
+	fields := []*proto2.FieldSchema{
+		{FieldName: proto.String("f1"), FieldType: proto.Int32(influx.Field_Type_Float)},
+		{FieldName: proto.String("t1"), FieldType: proto.Int32(influx.Field_Type_Tag)},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			msti.RLockSchema()
+			_ = len(msti.Schema)
+			msti.RUnlockSchema()
+		}
+	}()
+	require.NoError(t, msti.CreateFields(fields, nextID, 0))
+	msti.RefKey(11)
+	msti.RefKey(11)
+	msti.RefKey(12)
+	msti.RefKey(100)
+	<-done
+	require.Equal(t, map[string]KeyInfo{
+		"f1": {ID: 11, Ref: 2, Type: influx.Field_Type_Float},
+		"t1": {ID: 12, Ref: 1, Type: influx.Field_Type_Tag},
+	}, msti.Schema)
+
+	// the schema is left unchanged on conflicts
+	conflict := []*proto2.FieldSchema{
+		{FieldName: proto.String("f2"), FieldType: proto.Int32(influx.Field_Type_Int)},
+		{FieldName: proto.String("f1"), FieldType: proto.Int32(influx.Field_Type_Int)},
+	}
+	require.Equal(t, ErrFieldTypeConflict, msti.CreateFields(conflict, nextID, 0))
+	require.Equal(t, 2, len(msti.Schema))
+
+	msti.UnrefKey(11)
+	msti.UnrefKey(12)
+	msti.UnrefKey(100)
+	require.Equal(t, map[string]KeyInfo{"f1": {ID: 11, Ref: 1, Type: influx.Field_Type_Float}}, msti.Schema)
+}
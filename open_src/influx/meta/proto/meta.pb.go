@@ -225,7 +225,7 @@ func (x *Command_Type) UnmarshalJSON(data []byte) error {
 }
 
 func (Command_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{27, 0}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{29, 0}
 }
 
 type Data struct {
@@ -915,14 +915,20 @@ func (m *KeyInfo) GetType() int32 {
 }
 
 type MeasurementInfo struct {
-	Name                 *string             `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	ShardKeys            []*ShardKeyInfo     `protobuf:"bytes,2,rep,name=ShardKeys" json:"ShardKeys,omitempty"`
-	Schema               map[string]*KeyInfo `protobuf:"bytes,3,rep,name=Schema" json:"Schema,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	MarkDeleted          *bool               `protobuf:"varint,4,opt,name=MarkDeleted" json:"MarkDeleted,omitempty"`
-	IndexRelation        *IndexRelation      `protobuf:"bytes,5,opt,name=indexRelation" json:"indexRelation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Name                 *string                        `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	ShardKeys            []*ShardKeyInfo                `protobuf:"bytes,2,rep,name=ShardKeys" json:"ShardKeys,omitempty"`
+	Schema               map[string]*KeyInfo            `protobuf:"bytes,3,rep,name=Schema" json:"Schema,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MarkDeleted          *bool                          `protobuf:"varint,4,opt,name=MarkDeleted" json:"MarkDeleted,omitempty"`
+	IndexRelation        *IndexRelation                 `protobuf:"bytes,5,opt,name=indexRelation" json:"indexRelation,omitempty"`
+	TTL                  *int64                         `protobuf:"varint,6,opt,name=TTL" json:"TTL,omitempty"`
+	EstimatedCardinality *uint64                        `protobuf:"varint,7,opt,name=EstimatedCardinality" json:"EstimatedCardinality,omitempty"`
+	UpdatedAt            *int64                         `protobuf:"varint,8,opt,name=UpdatedAt" json:"UpdatedAt,omitempty"`
+	RetentionOverride    *int64                         `protobuf:"varint,9,opt,name=RetentionOverride" json:"RetentionOverride,omitempty"`
+	Aliases              map[string]string              `protobuf:"bytes,10,rep,name=Aliases" json:"Aliases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DownSamplePolicies   []*MeasurementDownSamplePolicy `protobuf:"bytes,11,rep,name=DownSamplePolicies" json:"DownSamplePolicies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *MeasurementInfo) Reset()         { *m = MeasurementInfo{} }
@@ -984,6 +990,148 @@ func (m *MeasurementInfo) GetIndexRelation() *IndexRelation {
 	return nil
 }
 
+func (m *MeasurementInfo) GetTTL() int64 {
+	if m != nil && m.TTL != nil {
+		return *m.TTL
+	}
+	return 0
+}
+
+func (m *MeasurementInfo) GetEstimatedCardinality() uint64 {
+	if m != nil && m.EstimatedCardinality != nil {
+		return *m.EstimatedCardinality
+	}
+	return 0
+}
+
+func (m *MeasurementInfo) GetUpdatedAt() int64 {
+	if m != nil && m.UpdatedAt != nil {
+		return *m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *MeasurementInfo) GetRetentionOverride() int64 {
+	if m != nil && m.RetentionOverride != nil {
+		return *m.RetentionOverride
+	}
+	return 0
+}
+
+func (m *MeasurementInfo) GetAliases() map[string]string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *MeasurementInfo) GetDownSamplePolicies() []*MeasurementDownSamplePolicy {
+	if m != nil {
+		return m.DownSamplePolicies
+	}
+	return nil
+}
+
+type MeasurementDownSamplePolicy struct {
+	Interval             *int64                  `protobuf:"varint,1,req,name=Interval" json:"Interval,omitempty"`
+	Calls                []*DownSampleFieldCalls `protobuf:"bytes,2,rep,name=Calls" json:"Calls,omitempty"`
+	DestMeasurement      *string                 `protobuf:"bytes,3,req,name=DestMeasurement" json:"DestMeasurement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *MeasurementDownSamplePolicy) Reset()         { *m = MeasurementDownSamplePolicy{} }
+func (m *MeasurementDownSamplePolicy) String() string { return proto.CompactTextString(m) }
+func (*MeasurementDownSamplePolicy) ProtoMessage()    {}
+func (*MeasurementDownSamplePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{10}
+}
+func (m *MeasurementDownSamplePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeasurementDownSamplePolicy.Unmarshal(m, b)
+}
+func (m *MeasurementDownSamplePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeasurementDownSamplePolicy.Marshal(b, m, deterministic)
+}
+func (m *MeasurementDownSamplePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeasurementDownSamplePolicy.Merge(m, src)
+}
+func (m *MeasurementDownSamplePolicy) XXX_Size() int {
+	return xxx_messageInfo_MeasurementDownSamplePolicy.Size(m)
+}
+func (m *MeasurementDownSamplePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeasurementDownSamplePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeasurementDownSamplePolicy proto.InternalMessageInfo
+
+func (m *MeasurementDownSamplePolicy) GetInterval() int64 {
+	if m != nil && m.Interval != nil {
+		return *m.Interval
+	}
+	return 0
+}
+
+func (m *MeasurementDownSamplePolicy) GetCalls() []*DownSampleFieldCalls {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *MeasurementDownSamplePolicy) GetDestMeasurement() string {
+	if m != nil && m.DestMeasurement != nil {
+		return *m.DestMeasurement
+	}
+	return ""
+}
+
+type DownSampleFieldCalls struct {
+	Field                *string  `protobuf:"bytes,1,req,name=Field" json:"Field,omitempty"`
+	AggOps               []string `protobuf:"bytes,2,rep,name=AggOps" json:"AggOps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownSampleFieldCalls) Reset()         { *m = DownSampleFieldCalls{} }
+func (m *DownSampleFieldCalls) String() string { return proto.CompactTextString(m) }
+func (*DownSampleFieldCalls) ProtoMessage()    {}
+func (*DownSampleFieldCalls) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{11}
+}
+func (m *DownSampleFieldCalls) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownSampleFieldCalls.Unmarshal(m, b)
+}
+func (m *DownSampleFieldCalls) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownSampleFieldCalls.Marshal(b, m, deterministic)
+}
+func (m *DownSampleFieldCalls) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownSampleFieldCalls.Merge(m, src)
+}
+func (m *DownSampleFieldCalls) XXX_Size() int {
+	return xxx_messageInfo_DownSampleFieldCalls.Size(m)
+}
+func (m *DownSampleFieldCalls) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownSampleFieldCalls.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownSampleFieldCalls proto.InternalMessageInfo
+
+func (m *DownSampleFieldCalls) GetField() string {
+	if m != nil && m.Field != nil {
+		return *m.Field
+	}
+	return ""
+}
+
+func (m *DownSampleFieldCalls) GetAggOps() []string {
+	if m != nil {
+		return m.AggOps
+	}
+	return nil
+}
+
 type RetentionPolicyInfo struct {
 	Name                 *string               `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
 	Duration             *int64                `protobuf:"varint,2,req,name=Duration" json:"Duration,omitempty"`
@@ -1008,7 +1156,7 @@ func (m *RetentionPolicyInfo) Reset()         { *m = RetentionPolicyInfo{} }
 func (m *RetentionPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*RetentionPolicyInfo) ProtoMessage()    {}
 func (*RetentionPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{10}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{12}
 }
 func (m *RetentionPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RetentionPolicyInfo.Unmarshal(m, b)
@@ -1142,7 +1290,7 @@ func (m *ShardGroupInfo) Reset()         { *m = ShardGroupInfo{} }
 func (m *ShardGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardGroupInfo) ProtoMessage()    {}
 func (*ShardGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{11}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{13}
 }
 func (m *ShardGroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardGroupInfo.Unmarshal(m, b)
@@ -1224,7 +1372,7 @@ func (m *ShardInfo) Reset()         { *m = ShardInfo{} }
 func (m *ShardInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardInfo) ProtoMessage()    {}
 func (*ShardInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{12}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{14}
 }
 func (m *ShardInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardInfo.Unmarshal(m, b)
@@ -1328,7 +1476,7 @@ func (m *ShardKeyInfo) Reset()         { *m = ShardKeyInfo{} }
 func (m *ShardKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardKeyInfo) ProtoMessage()    {}
 func (*ShardKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{13}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{15}
 }
 func (m *ShardKeyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardKeyInfo.Unmarshal(m, b)
@@ -1382,7 +1530,7 @@ func (m *SubscriptionInfo) Reset()         { *m = SubscriptionInfo{} }
 func (m *SubscriptionInfo) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionInfo) ProtoMessage()    {}
 func (*SubscriptionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{14}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{16}
 }
 func (m *SubscriptionInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriptionInfo.Unmarshal(m, b)
@@ -1434,7 +1582,7 @@ func (m *ShardOwner) Reset()         { *m = ShardOwner{} }
 func (m *ShardOwner) String() string { return proto.CompactTextString(m) }
 func (*ShardOwner) ProtoMessage()    {}
 func (*ShardOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{15}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{17}
 }
 func (m *ShardOwner) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardOwner.Unmarshal(m, b)
@@ -1476,7 +1624,7 @@ func (m *UserInfo) Reset()         { *m = UserInfo{} }
 func (m *UserInfo) String() string { return proto.CompactTextString(m) }
 func (*UserInfo) ProtoMessage()    {}
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{16}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{18}
 }
 func (m *UserInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserInfo.Unmarshal(m, b)
@@ -1543,7 +1691,7 @@ func (m *UserPrivilege) Reset()         { *m = UserPrivilege{} }
 func (m *UserPrivilege) String() string { return proto.CompactTextString(m) }
 func (*UserPrivilege) ProtoMessage()    {}
 func (*UserPrivilege) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{17}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{19}
 }
 func (m *UserPrivilege) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserPrivilege.Unmarshal(m, b)
@@ -1591,7 +1739,7 @@ func (m *IndexRelation) Reset()         { *m = IndexRelation{} }
 func (m *IndexRelation) String() string { return proto.CompactTextString(m) }
 func (*IndexRelation) ProtoMessage()    {}
 func (*IndexRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{18}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{20}
 }
 func (m *IndexRelation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexRelation.Unmarshal(m, b)
@@ -1650,7 +1798,7 @@ func (m *IndexList) Reset()         { *m = IndexList{} }
 func (m *IndexList) String() string { return proto.CompactTextString(m) }
 func (*IndexList) ProtoMessage()    {}
 func (*IndexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{19}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{21}
 }
 func (m *IndexList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexList.Unmarshal(m, b)
@@ -1688,7 +1836,7 @@ func (m *RpMeasurementsFieldsInfo) Reset()         { *m = RpMeasurementsFieldsIn
 func (m *RpMeasurementsFieldsInfo) String() string { return proto.CompactTextString(m) }
 func (*RpMeasurementsFieldsInfo) ProtoMessage()    {}
 func (*RpMeasurementsFieldsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{20}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{22}
 }
 func (m *RpMeasurementsFieldsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpMeasurementsFieldsInfo.Unmarshal(m, b)
@@ -1727,7 +1875,7 @@ func (m *MeasurementFieldsInfo) Reset()         { *m = MeasurementFieldsInfo{} }
 func (m *MeasurementFieldsInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementFieldsInfo) ProtoMessage()    {}
 func (*MeasurementFieldsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{21}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{23}
 }
 func (m *MeasurementFieldsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementFieldsInfo.Unmarshal(m, b)
@@ -1773,7 +1921,7 @@ func (m *MeasurementTypeFields) Reset()         { *m = MeasurementTypeFields{} }
 func (m *MeasurementTypeFields) String() string { return proto.CompactTextString(m) }
 func (*MeasurementTypeFields) ProtoMessage()    {}
 func (*MeasurementTypeFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{22}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{24}
 }
 func (m *MeasurementTypeFields) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementTypeFields.Unmarshal(m, b)
@@ -1825,7 +1973,7 @@ func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
 func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamInfo) ProtoMessage()    {}
 func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{23}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{25}
 }
 func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfo.Unmarshal(m, b)
@@ -1912,7 +2060,7 @@ func (m *StreamInfos) Reset()         { *m = StreamInfos{} }
 func (m *StreamInfos) String() string { return proto.CompactTextString(m) }
 func (*StreamInfos) ProtoMessage()    {}
 func (*StreamInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{24}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{26}
 }
 func (m *StreamInfos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfos.Unmarshal(m, b)
@@ -1952,7 +2100,7 @@ func (m *StreamMeasurementInfo) Reset()         { *m = StreamMeasurementInfo{} }
 func (m *StreamMeasurementInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamMeasurementInfo) ProtoMessage()    {}
 func (*StreamMeasurementInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{25}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{27}
 }
 func (m *StreamMeasurementInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeasurementInfo.Unmarshal(m, b)
@@ -2006,7 +2154,7 @@ func (m *StreamCall) Reset()         { *m = StreamCall{} }
 func (m *StreamCall) String() string { return proto.CompactTextString(m) }
 func (*StreamCall) ProtoMessage()    {}
 func (*StreamCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{26}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{28}
 }
 func (m *StreamCall) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamCall.Unmarshal(m, b)
@@ -2059,7 +2207,7 @@ func (m *Command) Reset()         { *m = Command{} }
 func (m *Command) String() string { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()    {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{27}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{29}
 }
 
 var extRange_Command = []proto.ExtensionRange{
@@ -2109,7 +2257,7 @@ func (m *CreateDatabaseCommand) Reset()         { *m = CreateDatabaseCommand{} }
 func (m *CreateDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabaseCommand) ProtoMessage()    {}
 func (*CreateDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{28}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{30}
 }
 func (m *CreateDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDatabaseCommand.Unmarshal(m, b)
@@ -2177,7 +2325,7 @@ func (m *DropDatabaseCommand) Reset()         { *m = DropDatabaseCommand{} }
 func (m *DropDatabaseCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDatabaseCommand) ProtoMessage()    {}
 func (*DropDatabaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{29}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{31}
 }
 func (m *DropDatabaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDatabaseCommand.Unmarshal(m, b)
@@ -2226,7 +2374,7 @@ func (m *CreateRetentionPolicyCommand) Reset()         { *m = CreateRetentionPol
 func (m *CreateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateRetentionPolicyCommand) ProtoMessage()    {}
 func (*CreateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{30}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{32}
 }
 func (m *CreateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRetentionPolicyCommand.Unmarshal(m, b)
@@ -2288,7 +2436,7 @@ func (m *DropRetentionPolicyCommand) Reset()         { *m = DropRetentionPolicyC
 func (m *DropRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropRetentionPolicyCommand) ProtoMessage()    {}
 func (*DropRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{31}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{33}
 }
 func (m *DropRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropRetentionPolicyCommand.Unmarshal(m, b)
@@ -2343,7 +2491,7 @@ func (m *SetDefaultRetentionPolicyCommand) Reset()         { *m = SetDefaultRete
 func (m *SetDefaultRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDefaultRetentionPolicyCommand) ProtoMessage()    {}
 func (*SetDefaultRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{32}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{34}
 }
 func (m *SetDefaultRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDefaultRetentionPolicyCommand.Unmarshal(m, b)
@@ -2406,7 +2554,7 @@ func (m *UpdateRetentionPolicyCommand) Reset()         { *m = UpdateRetentionPol
 func (m *UpdateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateRetentionPolicyCommand) ProtoMessage()    {}
 func (*UpdateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{33}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{35}
 }
 func (m *UpdateRetentionPolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRetentionPolicyCommand.Unmarshal(m, b)
@@ -2519,7 +2667,7 @@ func (m *CreateShardGroupCommand) Reset()         { *m = CreateShardGroupCommand
 func (m *CreateShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateShardGroupCommand) ProtoMessage()    {}
 func (*CreateShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{34}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{36}
 }
 func (m *CreateShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateShardGroupCommand.Unmarshal(m, b)
@@ -2589,7 +2737,7 @@ func (m *DeleteShardGroupCommand) Reset()         { *m = DeleteShardGroupCommand
 func (m *DeleteShardGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardGroupCommand) ProtoMessage()    {}
 func (*DeleteShardGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{35}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{37}
 }
 func (m *DeleteShardGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteShardGroupCommand.Unmarshal(m, b)
@@ -2653,7 +2801,7 @@ func (m *CreateUserCommand) Reset()         { *m = CreateUserCommand{} }
 func (m *CreateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateUserCommand) ProtoMessage()    {}
 func (*CreateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{36}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{38}
 }
 func (m *CreateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateUserCommand.Unmarshal(m, b)
@@ -2721,7 +2869,7 @@ func (m *DropUserCommand) Reset()         { *m = DropUserCommand{} }
 func (m *DropUserCommand) String() string { return proto.CompactTextString(m) }
 func (*DropUserCommand) ProtoMessage()    {}
 func (*DropUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{37}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{39}
 }
 func (m *DropUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropUserCommand.Unmarshal(m, b)
@@ -2769,7 +2917,7 @@ func (m *UpdateUserCommand) Reset()         { *m = UpdateUserCommand{} }
 func (m *UpdateUserCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserCommand) ProtoMessage()    {}
 func (*UpdateUserCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{38}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{40}
 }
 func (m *UpdateUserCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateUserCommand.Unmarshal(m, b)
@@ -2825,7 +2973,7 @@ func (m *SetPrivilegeCommand) Reset()         { *m = SetPrivilegeCommand{} }
 func (m *SetPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetPrivilegeCommand) ProtoMessage()    {}
 func (*SetPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{39}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{41}
 }
 func (m *SetPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPrivilegeCommand.Unmarshal(m, b)
@@ -2886,7 +3034,7 @@ func (m *SetDataCommand) Reset()         { *m = SetDataCommand{} }
 func (m *SetDataCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDataCommand) ProtoMessage()    {}
 func (*SetDataCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{40}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{42}
 }
 func (m *SetDataCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDataCommand.Unmarshal(m, b)
@@ -2934,7 +3082,7 @@ func (m *SetAdminPrivilegeCommand) Reset()         { *m = SetAdminPrivilegeComma
 func (m *SetAdminPrivilegeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetAdminPrivilegeCommand) ProtoMessage()    {}
 func (*SetAdminPrivilegeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{41}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{43}
 }
 func (m *SetAdminPrivilegeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAdminPrivilegeCommand.Unmarshal(m, b)
@@ -2992,7 +3140,7 @@ func (m *CreateSubscriptionCommand) Reset()         { *m = CreateSubscriptionCom
 func (m *CreateSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSubscriptionCommand) ProtoMessage()    {}
 func (*CreateSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{42}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{44}
 }
 func (m *CreateSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSubscriptionCommand.Unmarshal(m, b)
@@ -3069,7 +3217,7 @@ func (m *DropSubscriptionCommand) Reset()         { *m = DropSubscriptionCommand
 func (m *DropSubscriptionCommand) String() string { return proto.CompactTextString(m) }
 func (*DropSubscriptionCommand) ProtoMessage()    {}
 func (*DropSubscriptionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{43}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{45}
 }
 func (m *DropSubscriptionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropSubscriptionCommand.Unmarshal(m, b)
@@ -3133,7 +3281,7 @@ func (m *CreateMetaNodeCommand) Reset()         { *m = CreateMetaNodeCommand{} }
 func (m *CreateMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMetaNodeCommand) ProtoMessage()    {}
 func (*CreateMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{44}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{46}
 }
 func (m *CreateMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMetaNodeCommand.Unmarshal(m, b)
@@ -3202,7 +3350,7 @@ func (m *CreateDataNodeCommand) Reset()         { *m = CreateDataNodeCommand{} }
 func (m *CreateDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDataNodeCommand) ProtoMessage()    {}
 func (*CreateDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{45}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{47}
 }
 func (m *CreateDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDataNodeCommand.Unmarshal(m, b)
@@ -3258,7 +3406,7 @@ func (m *DataNodeEvent) Reset()         { *m = DataNodeEvent{} }
 func (m *DataNodeEvent) String() string { return proto.CompactTextString(m) }
 func (*DataNodeEvent) ProtoMessage()    {}
 func (*DataNodeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{46}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{48}
 }
 func (m *DataNodeEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataNodeEvent.Unmarshal(m, b)
@@ -3310,7 +3458,7 @@ func (m *DeleteMetaNodeCommand) Reset()         { *m = DeleteMetaNodeCommand{} }
 func (m *DeleteMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetaNodeCommand) ProtoMessage()    {}
 func (*DeleteMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{47}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{49}
 }
 func (m *DeleteMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMetaNodeCommand.Unmarshal(m, b)
@@ -3357,7 +3505,7 @@ func (m *DeleteDataNodeCommand) Reset()         { *m = DeleteDataNodeCommand{} }
 func (m *DeleteDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteDataNodeCommand) ProtoMessage()    {}
 func (*DeleteDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{48}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{50}
 }
 func (m *DeleteDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDataNodeCommand.Unmarshal(m, b)
@@ -3406,7 +3554,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{49}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{51}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -3463,7 +3611,7 @@ func (m *SetMetaNodeCommand) Reset()         { *m = SetMetaNodeCommand{} }
 func (m *SetMetaNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*SetMetaNodeCommand) ProtoMessage()    {}
 func (*SetMetaNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{50}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{52}
 }
 func (m *SetMetaNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMetaNodeCommand.Unmarshal(m, b)
@@ -3531,7 +3679,7 @@ func (m *DropShardCommand) Reset()         { *m = DropShardCommand{} }
 func (m *DropShardCommand) String() string { return proto.CompactTextString(m) }
 func (*DropShardCommand) ProtoMessage()    {}
 func (*DropShardCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{51}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{53}
 }
 func (m *DropShardCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropShardCommand.Unmarshal(m, b)
@@ -3578,7 +3726,7 @@ func (m *MarkDatabaseDeleteCommand) Reset()         { *m = MarkDatabaseDeleteCom
 func (m *MarkDatabaseDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkDatabaseDeleteCommand) ProtoMessage()    {}
 func (*MarkDatabaseDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{52}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{54}
 }
 func (m *MarkDatabaseDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkDatabaseDeleteCommand.Unmarshal(m, b)
@@ -3628,7 +3776,7 @@ func (m *UpdateShardOwnerCommand) Reset()         { *m = UpdateShardOwnerCommand
 func (m *UpdateShardOwnerCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardOwnerCommand) ProtoMessage()    {}
 func (*UpdateShardOwnerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{53}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{55}
 }
 func (m *UpdateShardOwnerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardOwnerCommand.Unmarshal(m, b)
@@ -3697,7 +3845,7 @@ func (m *MarkRetentionPolicyDeleteCommand) Reset()         { *m = MarkRetentionP
 func (m *MarkRetentionPolicyDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkRetentionPolicyDeleteCommand) ProtoMessage()    {}
 func (*MarkRetentionPolicyDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{54}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{56}
 }
 func (m *MarkRetentionPolicyDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkRetentionPolicyDeleteCommand.Unmarshal(m, b)
@@ -3755,7 +3903,7 @@ func (m *CreateMeasurementCommand) Reset()         { *m = CreateMeasurementComma
 func (m *CreateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateMeasurementCommand) ProtoMessage()    {}
 func (*CreateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{55}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{57}
 }
 func (m *CreateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMeasurementCommand.Unmarshal(m, b)
@@ -3833,7 +3981,7 @@ func (m *AlterShardKeyCmd) Reset()         { *m = AlterShardKeyCmd{} }
 func (m *AlterShardKeyCmd) String() string { return proto.CompactTextString(m) }
 func (*AlterShardKeyCmd) ProtoMessage()    {}
 func (*AlterShardKeyCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{56}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{58}
 }
 func (m *AlterShardKeyCmd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlterShardKeyCmd.Unmarshal(m, b)
@@ -3905,7 +4053,7 @@ func (m *UpdateDbPtStatusCommand) Reset()         { *m = UpdateDbPtStatusCommand
 func (m *UpdateDbPtStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateDbPtStatusCommand) ProtoMessage()    {}
 func (*UpdateDbPtStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{57}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{59}
 }
 func (m *UpdateDbPtStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDbPtStatusCommand.Unmarshal(m, b)
@@ -3984,7 +4132,7 @@ func (m *ReShardingCommand) Reset()         { *m = ReShardingCommand{} }
 func (m *ReShardingCommand) String() string { return proto.CompactTextString(m) }
 func (*ReShardingCommand) ProtoMessage()    {}
 func (*ReShardingCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{58}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{60}
 }
 func (m *ReShardingCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReShardingCommand.Unmarshal(m, b)
@@ -4053,6 +4201,7 @@ type UpdateSchemaCommand struct {
 	RpName               *string        `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
 	Measurement          *string        `protobuf:"bytes,3,req,name=Measurement" json:"Measurement,omitempty"`
 	FieldToCreate        []*FieldSchema `protobuf:"bytes,4,rep,name=FieldToCreate" json:"FieldToCreate,omitempty"`
+	UpdatedAt            *int64         `protobuf:"varint,5,opt,name=UpdatedAt" json:"UpdatedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -4062,7 +4211,7 @@ func (m *UpdateSchemaCommand) Reset()         { *m = UpdateSchemaCommand{} }
 func (m *UpdateSchemaCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSchemaCommand) ProtoMessage()    {}
 func (*UpdateSchemaCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{59}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{61}
 }
 func (m *UpdateSchemaCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSchemaCommand.Unmarshal(m, b)
@@ -4110,6 +4259,13 @@ func (m *UpdateSchemaCommand) GetFieldToCreate() []*FieldSchema {
 	return nil
 }
 
+func (m *UpdateSchemaCommand) GetUpdatedAt() int64 {
+	if m != nil && m.UpdatedAt != nil {
+		return *m.UpdatedAt
+	}
+	return 0
+}
+
 var E_UpdateSchemaCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
 	ExtensionType: (*UpdateSchemaCommand)(nil),
@@ -4131,7 +4287,7 @@ func (m *FieldSchema) Reset()         { *m = FieldSchema{} }
 func (m *FieldSchema) String() string { return proto.CompactTextString(m) }
 func (*FieldSchema) ProtoMessage()    {}
 func (*FieldSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{60}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{62}
 }
 func (m *FieldSchema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FieldSchema.Unmarshal(m, b)
@@ -4178,7 +4334,7 @@ func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
 func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexInfo) ProtoMessage()    {}
 func (*IndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{61}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{63}
 }
 func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexInfo.Unmarshal(m, b)
@@ -4235,7 +4391,7 @@ func (m *IndexGroupInfo) Reset()         { *m = IndexGroupInfo{} }
 func (m *IndexGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*IndexGroupInfo) ProtoMessage()    {}
 func (*IndexGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{62}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{64}
 }
 func (m *IndexGroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexGroupInfo.Unmarshal(m, b)
@@ -4311,7 +4467,7 @@ func (m *ShardStatus) Reset()         { *m = ShardStatus{} }
 func (m *ShardStatus) String() string { return proto.CompactTextString(m) }
 func (*ShardStatus) ProtoMessage()    {}
 func (*ShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{63}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{65}
 }
 func (m *ShardStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardStatus.Unmarshal(m, b)
@@ -4371,7 +4527,7 @@ func (m *RpShardStatus) Reset()         { *m = RpShardStatus{} }
 func (m *RpShardStatus) String() string { return proto.CompactTextString(m) }
 func (*RpShardStatus) ProtoMessage()    {}
 func (*RpShardStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{64}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{66}
 }
 func (m *RpShardStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpShardStatus.Unmarshal(m, b)
@@ -4418,7 +4574,7 @@ func (m *DBPtStatus) Reset()         { *m = DBPtStatus{} }
 func (m *DBPtStatus) String() string { return proto.CompactTextString(m) }
 func (*DBPtStatus) ProtoMessage()    {}
 func (*DBPtStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{65}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{67}
 }
 func (m *DBPtStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DBPtStatus.Unmarshal(m, b)
@@ -4470,7 +4626,7 @@ func (m *ReportShardsLoadCommand) Reset()         { *m = ReportShardsLoadCommand
 func (m *ReportShardsLoadCommand) String() string { return proto.CompactTextString(m) }
 func (*ReportShardsLoadCommand) ProtoMessage()    {}
 func (*ReportShardsLoadCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{66}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{68}
 }
 func (m *ReportShardsLoadCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportShardsLoadCommand.Unmarshal(m, b)
@@ -4520,7 +4676,7 @@ func (m *DownSamplePolicyInfo) Reset()         { *m = DownSamplePolicyInfo{} }
 func (m *DownSamplePolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicyInfo) ProtoMessage()    {}
 func (*DownSamplePolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{67}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{69}
 }
 func (m *DownSamplePolicyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicyInfo.Unmarshal(m, b)
@@ -4581,7 +4737,7 @@ func (m *DownSamplePolicy) Reset()         { *m = DownSamplePolicy{} }
 func (m *DownSamplePolicy) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicy) ProtoMessage()    {}
 func (*DownSamplePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{68}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{70}
 }
 func (m *DownSamplePolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicy.Unmarshal(m, b)
@@ -4634,7 +4790,7 @@ func (m *DownSampleOperators) Reset()         { *m = DownSampleOperators{} }
 func (m *DownSampleOperators) String() string { return proto.CompactTextString(m) }
 func (*DownSampleOperators) ProtoMessage()    {}
 func (*DownSampleOperators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{69}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{71}
 }
 func (m *DownSampleOperators) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSampleOperators.Unmarshal(m, b)
@@ -4681,7 +4837,7 @@ func (m *DownSamplePolicyInfoWithDbRp) Reset()         { *m = DownSamplePolicyIn
 func (m *DownSamplePolicyInfoWithDbRp) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePolicyInfoWithDbRp) ProtoMessage()    {}
 func (*DownSamplePolicyInfoWithDbRp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{70}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{72}
 }
 func (m *DownSamplePolicyInfoWithDbRp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePolicyInfoWithDbRp.Unmarshal(m, b)
@@ -4733,7 +4889,7 @@ func (m *DownSamplePoliciesInfoWithDbRp) Reset()         { *m = DownSamplePolici
 func (m *DownSamplePoliciesInfoWithDbRp) String() string { return proto.CompactTextString(m) }
 func (*DownSamplePoliciesInfoWithDbRp) ProtoMessage()    {}
 func (*DownSamplePoliciesInfoWithDbRp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{71}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{73}
 }
 func (m *DownSamplePoliciesInfoWithDbRp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownSamplePoliciesInfoWithDbRp.Unmarshal(m, b)
@@ -4771,7 +4927,7 @@ func (m *ShardDownSampleUpdateInfos) Reset()         { *m = ShardDownSampleUpdat
 func (m *ShardDownSampleUpdateInfos) String() string { return proto.CompactTextString(m) }
 func (*ShardDownSampleUpdateInfos) ProtoMessage()    {}
 func (*ShardDownSampleUpdateInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{72}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{74}
 }
 func (m *ShardDownSampleUpdateInfos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDownSampleUpdateInfos.Unmarshal(m, b)
@@ -4810,7 +4966,7 @@ func (m *ShardDownSampleUpdateInfo) Reset()         { *m = ShardDownSampleUpdate
 func (m *ShardDownSampleUpdateInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardDownSampleUpdateInfo) ProtoMessage()    {}
 func (*ShardDownSampleUpdateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{73}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{75}
 }
 func (m *ShardDownSampleUpdateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDownSampleUpdateInfo.Unmarshal(m, b)
@@ -4856,7 +5012,7 @@ func (m *PruneGroupsCommand) Reset()         { *m = PruneGroupsCommand{} }
 func (m *PruneGroupsCommand) String() string { return proto.CompactTextString(m) }
 func (*PruneGroupsCommand) ProtoMessage()    {}
 func (*PruneGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{74}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{76}
 }
 func (m *PruneGroupsCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PruneGroupsCommand.Unmarshal(m, b)
@@ -4912,7 +5068,7 @@ func (m *MarkMeasurementDeleteCommand) Reset()         { *m = MarkMeasurementDel
 func (m *MarkMeasurementDeleteCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkMeasurementDeleteCommand) ProtoMessage()    {}
 func (*MarkMeasurementDeleteCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{75}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{77}
 }
 func (m *MarkMeasurementDeleteCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkMeasurementDeleteCommand.Unmarshal(m, b)
@@ -4975,7 +5131,7 @@ func (m *DropMeasurementCommand) Reset()         { *m = DropMeasurementCommand{}
 func (m *DropMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*DropMeasurementCommand) ProtoMessage()    {}
 func (*DropMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{76}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{78}
 }
 func (m *DropMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropMeasurementCommand.Unmarshal(m, b)
@@ -5040,7 +5196,7 @@ func (m *NodeStartInfo) Reset()         { *m = NodeStartInfo{} }
 func (m *NodeStartInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeStartInfo) ProtoMessage()    {}
 func (*NodeStartInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{77}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{79}
 }
 func (m *NodeStartInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeStartInfo.Unmarshal(m, b)
@@ -5108,7 +5264,7 @@ func (m *TimeRangeCommand) Reset()         { *m = TimeRangeCommand{} }
 func (m *TimeRangeCommand) String() string { return proto.CompactTextString(m) }
 func (*TimeRangeCommand) ProtoMessage()    {}
 func (*TimeRangeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{78}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{80}
 }
 func (m *TimeRangeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRangeCommand.Unmarshal(m, b)
@@ -5171,7 +5327,7 @@ func (m *ShardDurationCommand) Reset()         { *m = ShardDurationCommand{} }
 func (m *ShardDurationCommand) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationCommand) ProtoMessage()    {}
 func (*ShardDurationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{79}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{81}
 }
 func (m *ShardDurationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationCommand.Unmarshal(m, b)
@@ -5234,7 +5390,7 @@ func (m *DurationDescriptor) Reset()         { *m = DurationDescriptor{} }
 func (m *DurationDescriptor) String() string { return proto.CompactTextString(m) }
 func (*DurationDescriptor) ProtoMessage()    {}
 func (*DurationDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{80}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{82}
 }
 func (m *DurationDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DurationDescriptor.Unmarshal(m, b)
@@ -5294,7 +5450,7 @@ func (m *ShardIdentifier) Reset()         { *m = ShardIdentifier{} }
 func (m *ShardIdentifier) String() string { return proto.CompactTextString(m) }
 func (*ShardIdentifier) ProtoMessage()    {}
 func (*ShardIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{81}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{83}
 }
 func (m *ShardIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardIdentifier.Unmarshal(m, b)
@@ -5389,7 +5545,7 @@ func (m *TimeRangeInfo) Reset()         { *m = TimeRangeInfo{} }
 func (m *TimeRangeInfo) String() string { return proto.CompactTextString(m) }
 func (*TimeRangeInfo) ProtoMessage()    {}
 func (*TimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{82}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{84}
 }
 func (m *TimeRangeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRangeInfo.Unmarshal(m, b)
@@ -5436,7 +5592,7 @@ func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
 func (m *IndexDescriptor) String() string { return proto.CompactTextString(m) }
 func (*IndexDescriptor) ProtoMessage()    {}
 func (*IndexDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{83}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{85}
 }
 func (m *IndexDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexDescriptor.Unmarshal(m, b)
@@ -5489,7 +5645,7 @@ func (m *ShardDurationInfo) Reset()         { *m = ShardDurationInfo{} }
 func (m *ShardDurationInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationInfo) ProtoMessage()    {}
 func (*ShardDurationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{84}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{86}
 }
 func (m *ShardDurationInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationInfo.Unmarshal(m, b)
@@ -5537,7 +5693,7 @@ func (m *ShardTimeRangeInfo) Reset()         { *m = ShardTimeRangeInfo{} }
 func (m *ShardTimeRangeInfo) String() string { return proto.CompactTextString(m) }
 func (*ShardTimeRangeInfo) ProtoMessage()    {}
 func (*ShardTimeRangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{85}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{87}
 }
 func (m *ShardTimeRangeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardTimeRangeInfo.Unmarshal(m, b)
@@ -5597,7 +5753,7 @@ func (m *ShardDurationResponse) Reset()         { *m = ShardDurationResponse{} }
 func (m *ShardDurationResponse) String() string { return proto.CompactTextString(m) }
 func (*ShardDurationResponse) ProtoMessage()    {}
 func (*ShardDurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{86}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{88}
 }
 func (m *ShardDurationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShardDurationResponse.Unmarshal(m, b)
@@ -5644,7 +5800,7 @@ func (m *DeleteIndexGroupCommand) Reset()         { *m = DeleteIndexGroupCommand
 func (m *DeleteIndexGroupCommand) String() string { return proto.CompactTextString(m) }
 func (*DeleteIndexGroupCommand) ProtoMessage()    {}
 func (*DeleteIndexGroupCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{87}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{89}
 }
 func (m *DeleteIndexGroupCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteIndexGroupCommand.Unmarshal(m, b)
@@ -5708,7 +5864,7 @@ func (m *UpdateShardInfoTierCommand) Reset()         { *m = UpdateShardInfoTierC
 func (m *UpdateShardInfoTierCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardInfoTierCommand) ProtoMessage()    {}
 func (*UpdateShardInfoTierCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{88}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{90}
 }
 func (m *UpdateShardInfoTierCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardInfoTierCommand.Unmarshal(m, b)
@@ -5777,7 +5933,7 @@ func (m *CardinalityInfo) Reset()         { *m = CardinalityInfo{} }
 func (m *CardinalityInfo) String() string { return proto.CompactTextString(m) }
 func (*CardinalityInfo) ProtoMessage()    {}
 func (*CardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{89}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{91}
 }
 func (m *CardinalityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardinalityInfo.Unmarshal(m, b)
@@ -5823,7 +5979,7 @@ func (m *MeasurementCardinalityInfo) Reset()         { *m = MeasurementCardinali
 func (m *MeasurementCardinalityInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementCardinalityInfo) ProtoMessage()    {}
 func (*MeasurementCardinalityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{90}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{92}
 }
 func (m *MeasurementCardinalityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementCardinalityInfo.Unmarshal(m, b)
@@ -5869,7 +6025,7 @@ func (m *CardinalityResponse) Reset()         { *m = CardinalityResponse{} }
 func (m *CardinalityResponse) String() string { return proto.CompactTextString(m) }
 func (*CardinalityResponse) ProtoMessage()    {}
 func (*CardinalityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{91}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{93}
 }
 func (m *CardinalityResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardinalityResponse.Unmarshal(m, b)
@@ -5917,7 +6073,7 @@ func (m *UpdateNodeStatusCommand) Reset()         { *m = UpdateNodeStatusCommand
 func (m *UpdateNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{92}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{94}
 }
 func (m *UpdateNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeStatusCommand.Unmarshal(m, b)
@@ -5987,7 +6143,7 @@ func (m *DbPt) Reset()         { *m = DbPt{} }
 func (m *DbPt) String() string { return proto.CompactTextString(m) }
 func (*DbPt) ProtoMessage()    {}
 func (*DbPt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{93}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{95}
 }
 func (m *DbPt) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DbPt.Unmarshal(m, b)
@@ -6047,7 +6203,7 @@ func (m *MigrateEventInfo) Reset()         { *m = MigrateEventInfo{} }
 func (m *MigrateEventInfo) String() string { return proto.CompactTextString(m) }
 func (*MigrateEventInfo) ProtoMessage()    {}
 func (*MigrateEventInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{94}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{96}
 }
 func (m *MigrateEventInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MigrateEventInfo.Unmarshal(m, b)
@@ -6141,7 +6297,7 @@ func (m *CreateEventCommand) Reset()         { *m = CreateEventCommand{} }
 func (m *CreateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateEventCommand) ProtoMessage()    {}
 func (*CreateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{95}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{97}
 }
 func (m *CreateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEventCommand.Unmarshal(m, b)
@@ -6188,7 +6344,7 @@ func (m *UpdateEventCommand) Reset()         { *m = UpdateEventCommand{} }
 func (m *UpdateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateEventCommand) ProtoMessage()    {}
 func (*UpdateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{96}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{98}
 }
 func (m *UpdateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateEventCommand.Unmarshal(m, b)
@@ -6238,7 +6394,7 @@ func (m *UpdatePtInfoCommand) Reset()         { *m = UpdatePtInfoCommand{} }
 func (m *UpdatePtInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtInfoCommand) ProtoMessage()    {}
 func (*UpdatePtInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{97}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{99}
 }
 func (m *UpdatePtInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtInfoCommand.Unmarshal(m, b)
@@ -6306,7 +6462,7 @@ func (m *RemoveEventCommand) Reset()         { *m = RemoveEventCommand{} }
 func (m *RemoveEventCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveEventCommand) ProtoMessage()    {}
 func (*RemoveEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{98}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{100}
 }
 func (m *RemoveEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveEventCommand.Unmarshal(m, b)
@@ -6355,7 +6511,7 @@ func (m *CreateDownSamplePolicyCommand) Reset()         { *m = CreateDownSampleP
 func (m *CreateDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDownSamplePolicyCommand) ProtoMessage()    {}
 func (*CreateDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{99}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{101}
 }
 func (m *CreateDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDownSamplePolicyCommand.Unmarshal(m, b)
@@ -6418,7 +6574,7 @@ func (m *DropDownSamplePolicyCommand) Reset()         { *m = DropDownSamplePolic
 func (m *DropDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDownSamplePolicyCommand) ProtoMessage()    {}
 func (*DropDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{100}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{102}
 }
 func (m *DropDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDownSamplePolicyCommand.Unmarshal(m, b)
@@ -6478,7 +6634,7 @@ func (m *GetDownSamplePolicyCommand) Reset()         { *m = GetDownSamplePolicyC
 func (m *GetDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*GetDownSamplePolicyCommand) ProtoMessage()    {}
 func (*GetDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{101}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{103}
 }
 func (m *GetDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDownSamplePolicyCommand.Unmarshal(m, b)
@@ -6518,7 +6674,7 @@ func (m *CreateDbPtViewCommand) Reset()         { *m = CreateDbPtViewCommand{} }
 func (m *CreateDbPtViewCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDbPtViewCommand) ProtoMessage()    {}
 func (*CreateDbPtViewCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{102}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{104}
 }
 func (m *CreateDbPtViewCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDbPtViewCommand.Unmarshal(m, b)
@@ -6567,7 +6723,7 @@ func (m *GetMeasurementInfoWithinSameRpCommand) Reset()         { *m = GetMeasur
 func (m *GetMeasurementInfoWithinSameRpCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementInfoWithinSameRpCommand) ProtoMessage()    {}
 func (*GetMeasurementInfoWithinSameRpCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{103}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{105}
 }
 func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Unmarshal(m, b)
@@ -6628,7 +6784,7 @@ func (m *UpdateShardDownSampleInfoCommand) Reset()         { *m = UpdateShardDow
 func (m *UpdateShardDownSampleInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardDownSampleInfoCommand) ProtoMessage()    {}
 func (*UpdateShardDownSampleInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{104}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
 }
 func (m *UpdateShardDownSampleInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Unmarshal(m, b)
@@ -6675,7 +6831,7 @@ func (m *MarkTakeoverCommand) Reset()         { *m = MarkTakeoverCommand{} }
 func (m *MarkTakeoverCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkTakeoverCommand) ProtoMessage()    {}
 func (*MarkTakeoverCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{105}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
 }
 func (m *MarkTakeoverCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkTakeoverCommand.Unmarshal(m, b)
@@ -6722,7 +6878,7 @@ func (m *MarkBalancerCommand) Reset()         { *m = MarkBalancerCommand{} }
 func (m *MarkBalancerCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkBalancerCommand) ProtoMessage()    {}
 func (*MarkBalancerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
 }
 func (m *MarkBalancerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkBalancerCommand.Unmarshal(m, b)
@@ -6769,7 +6925,7 @@ func (m *CreateStreamCommand) Reset()         { *m = CreateStreamCommand{} }
 func (m *CreateStreamCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateStreamCommand) ProtoMessage()    {}
 func (*CreateStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
 }
 func (m *CreateStreamCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStreamCommand.Unmarshal(m, b)
@@ -6816,7 +6972,7 @@ func (m *DropStreamCommand) Reset()         { *m = DropStreamCommand{} }
 func (m *DropStreamCommand) String() string { return proto.CompactTextString(m) }
 func (*DropStreamCommand) ProtoMessage()    {}
 func (*DropStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
 }
 func (m *DropStreamCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropStreamCommand.Unmarshal(m, b)
@@ -6865,7 +7021,7 @@ func (m *GetMeasurementInfoStoreCommand) Reset()         { *m = GetMeasurementIn
 func (m *GetMeasurementInfoStoreCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementInfoStoreCommand) ProtoMessage()    {}
 func (*GetMeasurementInfoStoreCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
 }
 func (m *GetMeasurementInfoStoreCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Unmarshal(m, b)
@@ -6926,7 +7082,7 @@ func (m *VerifyDataNodeCommand) Reset()         { *m = VerifyDataNodeCommand{} }
 func (m *VerifyDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*VerifyDataNodeCommand) ProtoMessage()    {}
 func (*VerifyDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
 }
 func (m *VerifyDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyDataNodeCommand.Unmarshal(m, b)
@@ -6972,7 +7128,7 @@ func (m *ExpandGroupsCommand) Reset()         { *m = ExpandGroupsCommand{} }
 func (m *ExpandGroupsCommand) String() string { return proto.CompactTextString(m) }
 func (*ExpandGroupsCommand) ProtoMessage()    {}
 func (*ExpandGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
 }
 func (m *ExpandGroupsCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExpandGroupsCommand.Unmarshal(m, b)
@@ -7013,7 +7169,7 @@ func (m *UpdatePtVersionCommand) Reset()         { *m = UpdatePtVersionCommand{}
 func (m *UpdatePtVersionCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtVersionCommand) ProtoMessage()    {}
 func (*UpdatePtVersionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
 }
 func (m *UpdatePtVersionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtVersionCommand.Unmarshal(m, b)
@@ -7071,7 +7227,7 @@ func (m *UpdateSchemaBitmapCommand) Reset()         { *m = UpdateSchemaBitmapCom
 func (m *UpdateSchemaBitmapCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSchemaBitmapCommand) ProtoMessage()    {}
 func (*UpdateSchemaBitmapCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
 }
 func (m *UpdateSchemaBitmapCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSchemaBitmapCommand.Unmarshal(m, b)
@@ -7148,7 +7304,10 @@ func init() {
 	proto.RegisterType((*RetentionPolicySpec)(nil), "proto.RetentionPolicySpec")
 	proto.RegisterType((*KeyInfo)(nil), "proto.KeyInfo")
 	proto.RegisterType((*MeasurementInfo)(nil), "proto.MeasurementInfo")
+	proto.RegisterMapType((map[string]string)(nil), "proto.MeasurementInfo.AliasesEntry")
 	proto.RegisterMapType((map[string]*KeyInfo)(nil), "proto.MeasurementInfo.SchemaEntry")
+	proto.RegisterType((*MeasurementDownSamplePolicy)(nil), "proto.MeasurementDownSamplePolicy")
+	proto.RegisterType((*DownSampleFieldCalls)(nil), "proto.DownSampleFieldCalls")
 	proto.RegisterType((*RetentionPolicyInfo)(nil), "proto.RetentionPolicyInfo")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.RetentionPolicyInfo.MstVersionsEntry")
 	proto.RegisterType((*ShardGroupInfo)(nil), "proto.ShardGroupInfo")
@@ -7319,332 +7478,341 @@ func init() {
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 5230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x5b, 0x70, 0x1d, 0xc9,
-	0x55, 0xd5, 0x73, 0xef, 0xd5, 0xa3, 0xa5, 0x2b, 0xcb, 0x6d, 0x59, 0x1e, 0x6b, 0x65, 0x5b, 0x9e,
-	0xf5, 0x62, 0x91, 0x87, 0x36, 0xab, 0xda, 0x38, 0x1b, 0x93, 0xdd, 0x8d, 0xa5, 0xeb, 0xb5, 0xef,
-	0xda, 0xb2, 0x6e, 0x5a, 0xca, 0xfa, 0x23, 0x14, 0x95, 0x91, 0x6e, 0xdb, 0x9e, 0xf5, 0x7d, 0x65,
-	0x66, 0x24, 0x5b, 0xa9, 0xa5, 0xd6, 0xc9, 0x7e, 0x50, 0x45, 0x3e, 0xa8, 0x14, 0x95, 0x2c, 0xa1,
-	0x8a, 0x40, 0xc8, 0x26, 0x10, 0x20, 0xb0, 0xe1, 0x15, 0x0a, 0x12, 0xaa, 0x08, 0x50, 0x45, 0xf1,
-	0xc1, 0x0f, 0xff, 0x7c, 0xf0, 0x0d, 0x14, 0xf0, 0x41, 0x8a, 0xbf, 0xd4, 0xe9, 0xc7, 0x74, 0xf7,
-	0x4c, 0xcf, 0x48, 0x76, 0x95, 0xf7, 0x4b, 0xb7, 0xcf, 0xe9, 0xe9, 0xf3, 0xe8, 0xd3, 0xa7, 0x4f,
-	0x9f, 0x3e, 0x2d, 0x8c, 0xfb, 0x2c, 0x0d, 0x57, 0x46, 0xf1, 0x30, 0x1d, 0x92, 0x06, 0xff, 0x13,
-	0xfc, 0xef, 0x38, 0xae, 0xb7, 0xc2, 0x34, 0x24, 0x04, 0xd7, 0xb7, 0x59, 0xdc, 0xf7, 0xd1, 0x92,
-	0xb7, 0x5c, 0xa7, 0xfc, 0x37, 0x99, 0xc3, 0x8d, 0xf6, 0xa0, 0xcb, 0x1e, 0xfa, 0x1e, 0x07, 0x8a,
-	0x06, 0x59, 0xc4, 0x93, 0xeb, 0xbd, 0xbd, 0x24, 0x65, 0x71, 0xbb, 0xe5, 0xd7, 0x38, 0x46, 0x03,
-	0xc8, 0x73, 0xb8, 0x71, 0x6b, 0xd8, 0x65, 0x89, 0x5f, 0x5f, 0xaa, 0x2d, 0x4f, 0xad, 0x1e, 0x13,
-	0xe4, 0x56, 0x00, 0xd6, 0x1e, 0xdc, 0x19, 0x52, 0x81, 0x25, 0x2f, 0xe0, 0x49, 0x20, 0xbb, 0x13,
-	0x26, 0x2c, 0xf1, 0x1b, 0xbc, 0xeb, 0x09, 0xd9, 0x55, 0xc1, 0x79, 0x77, 0xdd, 0x0b, 0x46, 0xfe,
-	0x6c, 0xc2, 0xe2, 0xc4, 0x1f, 0xb3, 0x46, 0x06, 0x98, 0x18, 0x99, 0x63, 0x81, 0xbd, 0x8d, 0xf0,
-	0x21, 0xa7, 0xd7, 0xf2, 0xc7, 0x05, 0x7b, 0x19, 0x80, 0x2c, 0xe3, 0x63, 0x1b, 0xe1, 0xc3, 0xad,
-	0x7b, 0x61, 0xdc, 0xbd, 0x16, 0x0f, 0xf7, 0x46, 0xed, 0x96, 0x3f, 0xc1, 0xfb, 0xe4, 0xc1, 0xe4,
-	0x2c, 0xc6, 0x0a, 0xd4, 0x6e, 0xf9, 0x93, 0xbc, 0x93, 0x01, 0x21, 0x1f, 0x15, 0x12, 0x08, 0x61,
-	0xb1, 0xc5, 0x92, 0x82, 0x53, 0xdd, 0x03, 0xba, 0x6f, 0x30, 0xd5, 0x7d, 0xca, 0xad, 0x1b, 0xdd,
-	0x83, 0x04, 0x78, 0x5a, 0xea, 0xb4, 0x93, 0xde, 0xda, 0xeb, 0xfb, 0x33, 0x4b, 0xde, 0x72, 0x93,
-	0x5a, 0x30, 0xf2, 0x3c, 0x1e, 0xeb, 0xa4, 0x6f, 0x44, 0xec, 0x81, 0x7f, 0x8c, 0x8f, 0x77, 0xca,
-	0x20, 0xbf, 0x22, 0x30, 0x57, 0x07, 0x69, 0x7c, 0x40, 0x65, 0x37, 0x18, 0x94, 0x7f, 0xd9, 0x61,
-	0x31, 0x50, 0xf1, 0x67, 0x97, 0x10, 0x0c, 0x6a, 0xc2, 0xa4, 0x82, 0xf8, 0x4c, 0x2b, 0x05, 0x1d,
-	0xcf, 0x14, 0x64, 0x82, 0xa5, 0x82, 0x38, 0xa8, 0xdd, 0xf2, 0x49, 0xa6, 0x20, 0x09, 0x01, 0x6a,
-	0x1b, 0xe1, 0xc3, 0xab, 0xfb, 0x6c, 0x90, 0x6e, 0x8e, 0xda, 0x5d, 0xff, 0xc4, 0x12, 0x5a, 0xae,
-	0x53, 0x0b, 0x06, 0xd4, 0xb6, 0xc3, 0xfb, 0x6c, 0x73, 0x9f, 0xc5, 0x57, 0x07, 0xe1, 0x4e, 0x8f,
-	0x75, 0xfd, 0xb9, 0x25, 0xb4, 0x3c, 0x41, 0xf3, 0x60, 0xf2, 0x32, 0x6e, 0x6e, 0x44, 0x77, 0xe3,
-	0x30, 0x65, 0xfc, 0xeb, 0xc4, 0x3f, 0x69, 0xc9, 0x6c, 0xe2, 0xb8, 0x2e, 0xed, 0xde, 0x40, 0x68,
-	0x2d, 0xec, 0x85, 0x83, 0x5d, 0x4d, 0x68, 0x5e, 0x10, 0xca, 0x81, 0xa5, 0x02, 0x5a, 0xc3, 0x07,
-	0x83, 0xad, 0xb0, 0x3f, 0xea, 0x81, 0x15, 0x9d, 0xe2, 0x9c, 0xe7, 0xc1, 0xe4, 0xc3, 0x78, 0x7c,
-	0x2b, 0x8d, 0x59, 0xd8, 0x4f, 0x7c, 0x9f, 0x33, 0x73, 0x5c, 0x32, 0x23, 0xa0, 0x9c, 0x0d, 0xd5,
-	0x83, 0x2c, 0xe1, 0x29, 0x30, 0x1e, 0x81, 0x69, 0xf9, 0xa7, 0xf9, 0x90, 0x26, 0x48, 0x1a, 0xee,
-	0xfa, 0x70, 0x30, 0x68, 0x77, 0xfd, 0x05, 0x8e, 0xd7, 0x80, 0x85, 0xd7, 0xf1, 0x94, 0x31, 0xa5,
-	0x64, 0x16, 0xd7, 0xee, 0xb3, 0x03, 0x1f, 0x2d, 0xa1, 0xe5, 0x49, 0x0a, 0x3f, 0x61, 0x79, 0xec,
-	0x87, 0xbd, 0x3d, 0xe6, 0x7b, 0x4b, 0xc8, 0xb4, 0xc5, 0xb5, 0x8e, 0x50, 0x88, 0xc0, 0x5e, 0xf6,
-	0x5e, 0x42, 0xc1, 0x79, 0x3c, 0xde, 0x49, 0x37, 0x1f, 0x0c, 0x58, 0x4c, 0xe6, 0xf1, 0x98, 0x5c,
-	0x2a, 0x62, 0xe1, 0xcb, 0x56, 0xd0, 0x03, 0xdb, 0x82, 0xef, 0xc8, 0x05, 0xdc, 0xe0, 0x5d, 0x79,
-	0x87, 0xa9, 0xd5, 0x19, 0x39, 0xae, 0x1c, 0x80, 0x36, 0xb2, 0x71, 0xb6, 0xd2, 0x30, 0xdd, 0x4b,
-	0xb8, 0xaf, 0x68, 0x52, 0xd9, 0x02, 0xb7, 0xd2, 0x49, 0xdb, 0x5d, 0xee, 0x27, 0x9a, 0x94, 0xff,
-	0x06, 0xde, 0xdf, 0x60, 0xb1, 0x5f, 0xe7, 0x22, 0xc2, 0xcf, 0xe0, 0xa3, 0x78, 0x42, 0xf1, 0x49,
-	0xce, 0xe3, 0x7a, 0x6b, 0xa7, 0x93, 0xfa, 0x88, 0xab, 0xb4, 0x99, 0x91, 0xe3, 0x42, 0x70, 0x54,
-	0xf0, 0x3e, 0xc2, 0x13, 0x6a, 0xd1, 0x90, 0x19, 0xec, 0x65, 0xdc, 0x7b, 0xed, 0x16, 0x50, 0xbc,
-	0x3e, 0x4c, 0x52, 0xce, 0xc7, 0x24, 0xe5, 0xbf, 0x89, 0x8f, 0xc7, 0x69, 0x67, 0xfd, 0x4a, 0xb7,
-	0x1b, 0xfb, 0x0d, 0xae, 0x31, 0xd5, 0x04, 0xcc, 0xf6, 0x7a, 0x87, 0x7f, 0x50, 0x13, 0x18, 0xd9,
-	0x34, 0x24, 0xaa, 0x2f, 0x79, 0xcb, 0xb5, 0x4c, 0xa2, 0x39, 0xdc, 0xb8, 0xb9, 0x1d, 0xf5, 0x99,
-	0x3f, 0x26, 0x9c, 0x22, 0x6f, 0xc0, 0x62, 0xb8, 0x36, 0x4c, 0x92, 0x68, 0xc4, 0x89, 0x8c, 0x73,
-	0xda, 0x06, 0x24, 0x60, 0x78, 0x42, 0xf9, 0x02, 0x72, 0x0e, 0x7b, 0xb7, 0x22, 0xa9, 0xce, 0x82,
-	0x0f, 0xf0, 0x6e, 0x45, 0x40, 0x9a, 0xcf, 0x7a, 0x8b, 0xcf, 0x65, 0x9d, 0xca, 0x16, 0xd8, 0xd0,
-	0x95, 0x5e, 0xb4, 0xcf, 0x24, 0xb2, 0x26, 0x6c, 0xc8, 0x00, 0x05, 0x3f, 0x45, 0x78, 0xda, 0xf4,
-	0x9f, 0xa0, 0x8d, 0x5b, 0x61, 0x9f, 0x71, 0x6a, 0x93, 0x94, 0xff, 0x26, 0x97, 0xf0, 0x7c, 0x8b,
-	0xdd, 0x09, 0xf7, 0x7a, 0x29, 0x65, 0x29, 0x1b, 0xa4, 0xd1, 0x70, 0xd0, 0x19, 0xf6, 0xa2, 0xdd,
-	0x03, 0xa9, 0xb3, 0x12, 0x2c, 0xb9, 0x8e, 0x8f, 0xdb, 0xa0, 0x88, 0x25, 0x7e, 0x8d, 0x4f, 0xd3,
-	0x82, 0x14, 0x23, 0xf7, 0x09, 0x97, 0xa8, 0xf8, 0x91, 0x58, 0x0c, 0xf1, 0xfd, 0x16, 0xeb, 0xb1,
-	0x94, 0x75, 0xf9, 0x9c, 0x4c, 0x50, 0x13, 0x44, 0x9e, 0xc7, 0x13, 0xdc, 0xd1, 0xde, 0x60, 0x07,
-	0xfe, 0x18, 0x37, 0x68, 0xb5, 0x3d, 0x28, 0x30, 0x1f, 0x3b, 0xeb, 0x14, 0x7c, 0x15, 0xe1, 0x13,
-	0x39, 0xea, 0x5b, 0x23, 0xb6, 0x6b, 0x28, 0x00, 0x65, 0x0a, 0x58, 0xc0, 0x13, 0xad, 0xbd, 0x38,
-	0x84, 0x9e, 0x5c, 0xc3, 0x35, 0x9a, 0xb5, 0xc9, 0x0a, 0x26, 0x7a, 0x1b, 0xc8, 0x7a, 0xd5, 0x78,
-	0x2f, 0x07, 0x06, 0xc6, 0xa2, 0x6c, 0xd4, 0x8b, 0x76, 0xc3, 0x5b, 0xdc, 0xa2, 0x9b, 0x34, 0x6b,
-	0x07, 0xaf, 0xe2, 0x71, 0xc9, 0x68, 0x66, 0xa5, 0x48, 0x5a, 0xe9, 0x2c, 0xae, 0x51, 0x76, 0x87,
-	0x53, 0x6f, 0x50, 0xf8, 0xc9, 0x37, 0xe0, 0x83, 0x11, 0xe3, 0xa4, 0x1a, 0x94, 0xff, 0x0e, 0x7e,
-	0xec, 0xe1, 0x63, 0x1b, 0x2c, 0x4c, 0xf6, 0x62, 0xd6, 0x97, 0x8e, 0xcd, 0x39, 0xa3, 0x2f, 0xe0,
-	0x49, 0xa5, 0x08, 0x58, 0x80, 0xb5, 0x32, 0x75, 0xe9, 0x5e, 0xe4, 0x32, 0x1e, 0xdb, 0xda, 0xbd,
-	0xc7, 0xfa, 0xa1, 0x9c, 0xc1, 0x40, 0x39, 0x52, 0x9b, 0xdc, 0x8a, 0xe8, 0x24, 0xf7, 0x11, 0xd1,
-	0xc8, 0x4f, 0x5f, 0xbd, 0x38, 0x7d, 0x97, 0x71, 0x33, 0x82, 0x6d, 0x80, 0xb2, 0x9e, 0x50, 0x60,
-	0x83, 0xcf, 0xe1, 0x9c, 0x24, 0xd2, 0x36, 0x71, 0xd4, 0xee, 0xba, 0xd0, 0xc6, 0x53, 0x06, 0x51,
-	0x87, 0xa7, 0xbb, 0x60, 0x7b, 0x3a, 0xe5, 0x91, 0x94, 0x90, 0x86, 0xa3, 0xfb, 0xbf, 0x46, 0xc1,
-	0x28, 0x4a, 0x75, 0x68, 0x1b, 0x85, 0x77, 0x24, 0xa3, 0xf0, 0x8e, 0x64, 0x14, 0x9e, 0x69, 0x14,
-	0xe4, 0x32, 0x9e, 0x36, 0x74, 0xac, 0x82, 0x9f, 0x79, 0xb7, 0xfa, 0xa9, 0xd5, 0x97, 0x6c, 0xe0,
-	0xa9, 0x8d, 0x24, 0x7d, 0x83, 0xc5, 0x49, 0x34, 0x1c, 0x24, 0xfe, 0x0c, 0xff, 0xf4, 0xc3, 0xe5,
-	0x6b, 0x6f, 0xc5, 0xe8, 0x2d, 0xa6, 0xd0, 0xfc, 0x9e, 0x7c, 0x02, 0x4f, 0x69, 0xe6, 0x55, 0x5c,
-	0x75, 0xd2, 0x34, 0x1c, 0xb1, 0xd7, 0x03, 0x23, 0x66, 0x4f, 0xd8, 0x8c, 0xb7, 0xf6, 0x76, 0x92,
-	0xdd, 0x38, 0x1a, 0xa5, 0x9c, 0x93, 0x71, 0x6b, 0x33, 0x36, 0x71, 0x62, 0x33, 0xb6, 0x7a, 0xe7,
-	0xed, 0x67, 0xa2, 0x68, 0x3f, 0x4b, 0x78, 0xea, 0xfa, 0x30, 0xcd, 0x34, 0x3d, 0xc9, 0x35, 0x6d,
-	0x82, 0x20, 0xba, 0xb8, 0x1d, 0xc6, 0xfd, 0xac, 0x0b, 0xe6, 0x5d, 0x2c, 0x18, 0x4c, 0x9b, 0x8e,
-	0x58, 0xb2, 0x9e, 0x53, 0x62, 0xda, 0x8a, 0x18, 0xd0, 0x87, 0x86, 0x26, 0xfe, 0xb4, 0xa5, 0x0f,
-	0x23, 0xf6, 0xe1, 0xfa, 0x30, 0x7a, 0x92, 0x4d, 0x3c, 0xa7, 0x23, 0x03, 0xad, 0x7e, 0xbf, 0xc9,
-	0x0d, 0xf4, 0x19, 0xb5, 0x15, 0x3b, 0xba, 0x50, 0xe7, 0x87, 0x0b, 0xaf, 0xe0, 0xd9, 0xfc, 0xd4,
-	0x39, 0x16, 0xc2, 0x9c, 0xb9, 0x10, 0x9a, 0xa6, 0xe1, 0xff, 0x04, 0xe1, 0x19, 0x7b, 0x02, 0x0b,
-	0xfb, 0xe4, 0x22, 0x9e, 0xdc, 0x4a, 0xc3, 0x38, 0xe5, 0x7b, 0x99, 0x30, 0x78, 0x0d, 0x80, 0x7d,
-	0xf1, 0xea, 0xa0, 0xcb, 0x71, 0xc2, 0xcc, 0x55, 0x13, 0xbe, 0x93, 0xb3, 0x74, 0x25, 0x95, 0x5b,
-	0xa3, 0x06, 0x90, 0x65, 0x3c, 0xc6, 0xe9, 0x2a, 0xbb, 0x9e, 0x35, 0xad, 0x89, 0x0b, 0x2c, 0xf1,
-	0x30, 0xc5, 0xdb, 0xf1, 0xde, 0x60, 0x37, 0x14, 0x23, 0x8d, 0x71, 0x0f, 0x6b, 0x82, 0x82, 0x77,
-	0x3d, 0xe9, 0xd6, 0x9c, 0xfc, 0x9f, 0xc5, 0x13, 0x3c, 0xf4, 0x68, 0xb7, 0x84, 0xcb, 0x6b, 0xae,
-	0x79, 0x3e, 0xa2, 0x19, 0x0c, 0xd4, 0xb5, 0x11, 0x89, 0x45, 0x3a, 0x49, 0xe1, 0x27, 0x87, 0x84,
-	0x0f, 0x39, 0xcf, 0x00, 0x09, 0x1f, 0x72, 0x9f, 0x1b, 0x31, 0x08, 0x0a, 0xc4, 0xa1, 0x27, 0x62,
-	0x3c, 0x22, 0x50, 0x31, 0xad, 0xd8, 0xe1, 0x55, 0x13, 0x22, 0x43, 0x3d, 0x59, 0x37, 0xd9, 0x3e,
-	0xeb, 0xf1, 0x8d, 0xbe, 0x46, 0xf3, 0x60, 0x30, 0x4e, 0x2b, 0x80, 0x9c, 0x10, 0xa1, 0xaf, 0x15,
-	0x3d, 0x72, 0x1f, 0x11, 0x76, 0x37, 0x07, 0xbd, 0x03, 0x7f, 0x92, 0xaf, 0x80, 0xac, 0x2d, 0x42,
-	0x6b, 0xb5, 0x1a, 0x7c, 0xcc, 0xb1, 0x06, 0x24, 0xa0, 0x78, 0xda, 0xf4, 0xeb, 0x30, 0x56, 0xb6,
-	0x5b, 0x42, 0xdc, 0x34, 0xa9, 0x37, 0xc6, 0x6c, 0x5f, 0xf1, 0xc4, 0x06, 0x08, 0xbf, 0x01, 0xb6,
-	0x75, 0x37, 0x8b, 0x20, 0xf8, 0xef, 0xe0, 0x97, 0xf0, 0x6c, 0x7e, 0xdd, 0x3a, 0xfd, 0x24, 0xc1,
-	0xf5, 0x0d, 0x38, 0x3c, 0xc8, 0xf8, 0x0a, 0x7e, 0x73, 0x79, 0x59, 0x92, 0x46, 0x83, 0x50, 0xb8,
-	0x83, 0x1a, 0xe7, 0xc1, 0x82, 0x05, 0x17, 0x30, 0xe6, 0x3c, 0x55, 0xc7, 0x9d, 0x5f, 0x47, 0x78,
-	0x42, 0x9d, 0xe8, 0xca, 0xc8, 0x5f, 0x0f, 0x93, 0x7b, 0x59, 0x78, 0x17, 0x26, 0xf7, 0x60, 0x1d,
-	0x5c, 0xe9, 0xf6, 0xe5, 0x64, 0x4f, 0x50, 0xd1, 0x00, 0x12, 0xf4, 0x01, 0x8c, 0x25, 0x37, 0x28,
-	0xd9, 0x22, 0x2f, 0x62, 0xdc, 0x89, 0xa3, 0xfd, 0xa8, 0xc7, 0xee, 0x66, 0x67, 0xcf, 0x39, 0xe3,
-	0x30, 0x99, 0x21, 0xa9, 0xd1, 0x2f, 0x68, 0xe3, 0xa6, 0x85, 0xe4, 0xfb, 0x85, 0x8c, 0xb4, 0x24,
-	0x83, 0x59, 0x1b, 0xd6, 0x48, 0xd6, 0x91, 0x73, 0xda, 0xa0, 0x1a, 0x10, 0xbc, 0x83, 0x70, 0xd3,
-	0xda, 0x01, 0x79, 0x34, 0x10, 0x75, 0xf9, 0x30, 0x4d, 0x0a, 0x3f, 0x01, 0xb2, 0x19, 0x75, 0x85,
-	0x61, 0x53, 0xf8, 0x09, 0x63, 0xf2, 0x8f, 0xb8, 0x46, 0x84, 0x82, 0x35, 0x80, 0x7c, 0x0c, 0x63,
-	0xde, 0xb8, 0x19, 0x25, 0xa9, 0x3a, 0x7b, 0xcf, 0x9a, 0x9e, 0x0b, 0x10, 0xd4, 0xe8, 0x13, 0x9c,
-	0x97, 0xe3, 0x41, 0x8b, 0x9f, 0xf4, 0xe1, 0x87, 0xb4, 0x1e, 0xd1, 0x08, 0xba, 0xd8, 0xa7, 0x23,
-	0x73, 0x03, 0x7a, 0x2d, 0x62, 0xbd, 0x6e, 0xc2, 0xe7, 0xe6, 0x3a, 0x9e, 0xcd, 0xed, 0x55, 0x89,
-	0x0c, 0xd9, 0x17, 0x8b, 0x5b, 0x99, 0xfe, 0x8e, 0x16, 0xbe, 0x0a, 0x86, 0xf8, 0xa4, 0xb3, 0x2b,
-	0xac, 0xc4, 0x8d, 0x24, 0x35, 0x2c, 0x40, 0x35, 0xc9, 0xa7, 0x30, 0x06, 0x3b, 0x16, 0x7d, 0x65,
-	0xc0, 0xe3, 0x20, 0xab, 0xfb, 0x50, 0xa3, 0x7f, 0xb0, 0x6e, 0x11, 0xd4, 0x08, 0xb0, 0x18, 0x39,
-	0xa4, 0x50, 0x83, 0x6c, 0x19, 0x4b, 0x08, 0x56, 0xbb, 0x08, 0xcd, 0xbe, 0xe2, 0x61, 0xac, 0xcf,
-	0x79, 0x4e, 0x53, 0x15, 0x1e, 0xcb, 0xcb, 0x3c, 0xd6, 0x8b, 0x78, 0x6c, 0x2b, 0xde, 0xdd, 0xe0,
-	0x47, 0x0d, 0xcf, 0xe0, 0x58, 0x0c, 0x93, 0xdf, 0xf9, 0x65, 0x5f, 0xf8, 0xaa, 0xc5, 0x12, 0xf8,
-	0xaa, 0x7e, 0x94, 0xaf, 0x44, 0x5f, 0xb0, 0xce, 0xf6, 0x20, 0x65, 0xf1, 0x7e, 0xd8, 0xe3, 0xde,
-	0xad, 0x46, 0xb3, 0x36, 0x4c, 0x76, 0x8b, 0xf5, 0xc2, 0x03, 0xee, 0xdf, 0x6a, 0x54, 0x34, 0x40,
-	0x82, 0x56, 0xd4, 0x17, 0x5b, 0xf9, 0x24, 0xe5, 0xbf, 0xc9, 0x45, 0xdc, 0x58, 0x0f, 0x7b, 0xbd,
-	0xc4, 0x9f, 0x70, 0x9c, 0x6f, 0x01, 0x43, 0x05, 0x3e, 0xb8, 0x84, 0xa7, 0xb4, 0x32, 0xf8, 0x77,
-	0xa6, 0x45, 0x38, 0xce, 0xc5, 0x02, 0x1f, 0x7c, 0x01, 0x9f, 0x74, 0xca, 0x51, 0x1a, 0xa1, 0xa9,
-	0x15, 0xe7, 0xe5, 0x56, 0xdc, 0x32, 0x3e, 0x96, 0x3f, 0xcc, 0x08, 0xcf, 0x9f, 0x07, 0x07, 0x37,
-	0xd5, 0xbc, 0x01, 0xe7, 0x40, 0x07, 0xfe, 0x2a, 0x3a, 0x1c, 0x36, 0x87, 0x1b, 0x7c, 0xe2, 0x25,
-	0x11, 0xd1, 0xe0, 0x4e, 0xa6, 0x17, 0x85, 0x89, 0x1c, 0x57, 0x34, 0x82, 0x6f, 0x35, 0xf1, 0xf8,
-	0xfa, 0xb0, 0xdf, 0x0f, 0x07, 0x5d, 0x72, 0x11, 0xd7, 0x53, 0x30, 0x13, 0x18, 0x6b, 0x26, 0x0b,
-	0xc0, 0x25, 0x76, 0x05, 0xac, 0x86, 0xf2, 0x0e, 0xc1, 0xbf, 0x4f, 0x0b, 0x83, 0x22, 0xa7, 0xf1,
-	0xc9, 0xf5, 0x98, 0x85, 0x29, 0x53, 0x72, 0xc8, 0xce, 0xb3, 0x35, 0x72, 0x0a, 0x9f, 0x68, 0xc5,
-	0xc3, 0x51, 0x1e, 0x51, 0x27, 0x4b, 0x78, 0x51, 0x7c, 0x93, 0x13, 0x4c, 0xf5, 0x68, 0x90, 0xb3,
-	0x78, 0x01, 0x3e, 0x2d, 0xc1, 0x8f, 0x91, 0x0b, 0x78, 0x69, 0x8b, 0xa5, 0xee, 0x43, 0x9e, 0xea,
-	0x35, 0x0e, 0x74, 0x3e, 0x3b, 0xea, 0x96, 0xd3, 0x99, 0x20, 0xcf, 0xe0, 0x53, 0x82, 0x13, 0x1d,
-	0x69, 0x28, 0xe4, 0x24, 0x20, 0xc5, 0x66, 0x55, 0x44, 0x62, 0x72, 0x12, 0x1f, 0x17, 0x5f, 0x82,
-	0x4b, 0x55, 0xe0, 0x26, 0x39, 0x81, 0x8f, 0x01, 0xe3, 0x26, 0x70, 0x06, 0xfa, 0x0a, 0x3e, 0x4c,
-	0xf0, 0x31, 0xd0, 0xcf, 0x16, 0x4b, 0x33, 0xa7, 0xaa, 0x10, 0xb3, 0x84, 0xe0, 0x19, 0x90, 0x2e,
-	0x4c, 0x43, 0x05, 0x3b, 0x4e, 0x16, 0xb1, 0xbf, 0xc5, 0x52, 0xbe, 0x2d, 0x14, 0xbe, 0x20, 0xe4,
-	0x0c, 0x3e, 0x2d, 0xe5, 0x30, 0xf6, 0x3f, 0x85, 0x3e, 0xc9, 0x25, 0x89, 0x87, 0x23, 0x17, 0x72,
-	0x5e, 0xcf, 0xa0, 0x4a, 0xdd, 0x29, 0x94, 0x6f, 0x4f, 0xae, 0x89, 0x3a, 0x0d, 0x28, 0x21, 0x53,
-	0x1e, 0xb5, 0x00, 0x28, 0xa1, 0xb7, 0xfc, 0x80, 0xcf, 0x68, 0x54, 0xfe, 0xab, 0x45, 0x32, 0x8f,
-	0xc9, 0x16, 0x4b, 0xf3, 0x9f, 0x9c, 0x21, 0x73, 0x78, 0x96, 0xf3, 0x0e, 0x73, 0xa0, 0xa0, 0x67,
-	0x41, 0x60, 0x1e, 0x4c, 0x48, 0xdb, 0x12, 0x83, 0x2a, 0xf4, 0x39, 0x10, 0x58, 0x70, 0xa7, 0xf7,
-	0x6b, 0x85, 0x7c, 0x16, 0x8c, 0x07, 0xbe, 0xcd, 0x19, 0x85, 0x3d, 0xc4, 0x45, 0x50, 0xb8, 0x52,
-	0x4b, 0xb6, 0xae, 0x15, 0xf6, 0x05, 0xe0, 0xea, 0x4a, 0x2f, 0x65, 0xb1, 0x8a, 0x51, 0xd6, 0xfb,
-	0xdd, 0xd9, 0x55, 0x98, 0x68, 0x2a, 0x48, 0x46, 0x83, 0xbb, 0xaa, 0xf3, 0x8b, 0x30, 0xd1, 0x92,
-	0x1b, 0x7e, 0x28, 0x54, 0x88, 0x8f, 0x03, 0x82, 0xb2, 0xd1, 0x30, 0x4e, 0x45, 0x40, 0xa9, 0x10,
-	0x97, 0x40, 0x19, 0x9d, 0x78, 0x6f, 0xc0, 0x44, 0x70, 0xae, 0xe0, 0x9f, 0x04, 0x8b, 0x06, 0xd6,
-	0x0d, 0x96, 0x6c, 0xb6, 0x2f, 0x93, 0x05, 0x3c, 0x0f, 0xea, 0x72, 0x30, 0xfd, 0x0b, 0xc0, 0x34,
-	0xc4, 0xbf, 0x34, 0x1c, 0x68, 0xdb, 0xf9, 0x14, 0xf1, 0xf1, 0x1c, 0x27, 0xaf, 0xce, 0x10, 0x0a,
-	0xf3, 0xb2, 0x5e, 0x00, 0xfa, 0xa0, 0xa0, 0x90, 0xaf, 0xc0, 0x12, 0x35, 0x54, 0x0c, 0x1e, 0x0f,
-	0x62, 0x4f, 0x85, 0x7f, 0x55, 0x4f, 0x01, 0x4c, 0xa7, 0x48, 0x3c, 0x29, 0xe4, 0xa7, 0x41, 0x3e,
-	0xa1, 0x5c, 0x9e, 0xdb, 0x54, 0xf0, 0x2b, 0x00, 0x17, 0x1f, 0x59, 0xf0, 0x35, 0xad, 0x41, 0x91,
-	0x44, 0x53, 0x88, 0x75, 0xf8, 0x80, 0xb2, 0xfe, 0x70, 0xdf, 0xfe, 0xa0, 0x45, 0xce, 0xe3, 0x33,
-	0xd2, 0x72, 0x73, 0x67, 0x13, 0xd5, 0xe5, 0x2a, 0x39, 0x87, 0x9f, 0xe1, 0xee, 0xa9, 0xa4, 0xc3,
-	0x6b, 0x20, 0xe1, 0x35, 0x96, 0x96, 0xe1, 0xaf, 0x19, 0xab, 0x63, 0x47, 0xe4, 0x35, 0x15, 0xea,
-	0x3a, 0xf9, 0x79, 0xfc, 0xdc, 0x35, 0x30, 0x66, 0x6b, 0x47, 0xb8, 0x1d, 0xa5, 0xf7, 0x22, 0x18,
-	0x8b, 0xd1, 0x4c, 0x8f, 0x6d, 0xb0, 0x46, 0x43, 0x8f, 0x46, 0x7c, 0x6d, 0xc8, 0xf9, 0x3a, 0x28,
-	0x00, 0x26, 0x7e, 0x3b, 0xbc, 0xcf, 0x86, 0xfb, 0x5a, 0xcd, 0x37, 0x14, 0x42, 0xa5, 0x80, 0x15,
-	0xe2, 0x26, 0x20, 0xa4, 0x4b, 0x10, 0x5b, 0x85, 0x44, 0x6c, 0x80, 0x91, 0xf2, 0x05, 0x65, 0x81,
-	0x6f, 0x91, 0x00, 0x9f, 0x2d, 0xb2, 0xbc, 0x95, 0x0e, 0xe3, 0xcc, 0x54, 0x36, 0x41, 0xe2, 0x37,
-	0x58, 0x1c, 0xdd, 0x39, 0xc8, 0x2f, 0xdf, 0x0e, 0x90, 0xbb, 0xfa, 0x70, 0x14, 0x0e, 0xba, 0xb6,
-	0xc9, 0x7e, 0x06, 0x0c, 0x52, 0x4d, 0x9d, 0x3c, 0x0c, 0x2a, 0x1c, 0x85, 0x55, 0x6c, 0x2e, 0x8c,
-	0xb5, 0x28, 0xed, 0x87, 0x99, 0x6a, 0xb6, 0x3e, 0x34, 0x31, 0xd1, 0x9d, 0x7d, 0xf4, 0xe8, 0xd1,
-	0x23, 0x2f, 0x78, 0xe4, 0x95, 0x6c, 0x33, 0xce, 0x5d, 0xb6, 0x55, 0xdc, 0x49, 0x45, 0x9e, 0xa5,
-	0x2a, 0xc7, 0x97, 0xff, 0x04, 0x4e, 0x30, 0x2a, 0xe3, 0xb1, 0xd7, 0xe7, 0xe7, 0x8c, 0x26, 0x35,
-	0x20, 0xe4, 0x39, 0x5c, 0xdb, 0xba, 0x1f, 0xf1, 0xc8, 0xbc, 0x24, 0x57, 0x05, 0xf8, 0xd5, 0xd7,
-	0xf0, 0xf8, 0xae, 0xe4, 0x75, 0xc6, 0xde, 0x4f, 0xfd, 0xbb, 0xfc, 0x53, 0x15, 0x0d, 0x39, 0xe5,
-	0xa3, 0xea, 0xe3, 0x60, 0xe8, 0xdc, 0x4d, 0x5d, 0xf2, 0xaf, 0xb6, 0xca, 0x49, 0xde, 0xb3, 0xf4,
-	0xe0, 0x18, 0x50, 0x13, 0xfc, 0x4f, 0x54, 0xbd, 0x4d, 0x57, 0x1e, 0x1f, 0x9c, 0x53, 0xe0, 0x3d,
-	0xee, 0x14, 0xf0, 0x83, 0xba, 0xd8, 0xe3, 0x3b, 0xf2, 0x64, 0xa4, 0x01, 0xab, 0x1b, 0xe5, 0x62,
-	0x46, 0x5c, 0xcc, 0x67, 0x2d, 0xcd, 0xba, 0xa5, 0xd0, 0xf2, 0x7e, 0x03, 0x55, 0x05, 0x1d, 0x95,
-	0xd2, 0xaa, 0x49, 0xf0, 0x8c, 0x49, 0xb8, 0x51, 0xce, 0xdd, 0x9b, 0x9c, 0xbb, 0xf3, 0xc6, 0x24,
-	0x1c, 0xc6, 0xdb, 0x77, 0xd0, 0xe1, 0x01, 0xcf, 0x63, 0x73, 0xf8, 0x99, 0x72, 0x0e, 0xef, 0x73,
-	0x0e, 0x2f, 0x2a, 0xa3, 0x3e, 0x84, 0xb2, 0xe6, 0xf3, 0x87, 0xb5, 0xea, 0x90, 0xeb, 0x71, 0x79,
-	0x84, 0x03, 0xd4, 0x2d, 0xf6, 0x40, 0x1e, 0x18, 0xf9, 0xe5, 0x86, 0x6c, 0x5a, 0xc9, 0xce, 0x7a,
-	0x2e, 0x03, 0x6e, 0x26, 0x2f, 0x1b, 0x76, 0x46, 0xbb, 0x24, 0x11, 0x3a, 0x56, 0x9a, 0x1d, 0xe7,
-	0x99, 0xbe, 0xfb, 0x4c, 0x2a, 0x80, 0xa7, 0x4b, 0x78, 0xa6, 0x2f, 0x03, 0x15, 0x33, 0x7d, 0xe8,
-	0xf0, 0x4c, 0x1f, 0x3a, 0x72, 0xa6, 0x0f, 0xb9, 0x33, 0x7d, 0x55, 0xd6, 0xdf, 0xb3, 0xac, 0xbf,
-	0x6a, 0x3e, 0xf4, 0xcc, 0xfd, 0x2b, 0x2a, 0x0d, 0x85, 0x2b, 0x27, 0x6d, 0x1e, 0x8f, 0x59, 0x37,
-	0x2f, 0x63, 0x7a, 0xe9, 0x42, 0xac, 0x91, 0xa4, 0x61, 0x7f, 0x24, 0xf3, 0x6f, 0x1a, 0xc0, 0x33,
-	0x77, 0x40, 0x86, 0xa7, 0xae, 0xea, 0xe2, 0x86, 0x3b, 0x03, 0xac, 0x5e, 0x2f, 0x17, 0xad, 0xcf,
-	0x45, 0x3b, 0x6b, 0x2d, 0xec, 0x02, 0xc3, 0x5a, 0xaa, 0xbf, 0x41, 0xa5, 0x31, 0xfc, 0x13, 0x49,
-	0x15, 0xc8, 0xac, 0x95, 0xba, 0x57, 0x16, 0xb5, 0x03, 0x16, 0xac, 0x8a, 0xfb, 0x81, 0xc5, 0x7d,
-	0x09, 0x63, 0x9a, 0xfb, 0xef, 0x23, 0xc7, 0x21, 0xe3, 0xe9, 0xa4, 0x94, 0x56, 0xd7, 0xca, 0xb9,
-	0xfe, 0x02, 0xe7, 0xda, 0xb7, 0x74, 0x6e, 0x30, 0xa4, 0xf9, 0xbd, 0x5b, 0x38, 0xfc, 0x38, 0xb7,
-	0xa7, 0x4f, 0x97, 0x93, 0x8a, 0x39, 0xa9, 0x79, 0xc3, 0x33, 0x3a, 0x09, 0xbd, 0xed, 0x38, 0x50,
-	0x1d, 0x55, 0x2f, 0x55, 0x92, 0x26, 0x96, 0xa4, 0x05, 0x12, 0x9a, 0x81, 0x1f, 0x20, 0xe7, 0xd9,
-	0x0d, 0x6c, 0x0a, 0xfa, 0x0f, 0x34, 0x1f, 0x59, 0xbb, 0xf2, 0xec, 0x6f, 0x65, 0xdb, 0x6a, 0xb9,
-	0x6c, 0x5b, 0xd5, 0x7e, 0x9e, 0x5a, 0xfb, 0xb9, 0x83, 0x25, 0xcd, 0x73, 0x9c, 0x3f, 0x55, 0x92,
-	0x73, 0xa2, 0x70, 0x46, 0xde, 0xe3, 0x4e, 0x19, 0xb5, 0x17, 0x94, 0x23, 0x56, 0x5f, 0x2d, 0x27,
-	0xbc, 0xc7, 0x09, 0x9f, 0x34, 0x76, 0x08, 0x3d, 0xb0, 0xa6, 0xf9, 0x2e, 0x2a, 0x3f, 0xb6, 0x56,
-	0x2a, 0x2b, 0x33, 0x5e, 0xcf, 0x30, 0xde, 0xd5, 0x76, 0x39, 0x3f, 0xfb, 0x9c, 0x9f, 0x73, 0x9a,
-	0x1f, 0x27, 0x4d, 0xcd, 0xd9, 0xff, 0xa3, 0x8a, 0x23, 0xf3, 0xd3, 0xcb, 0xdd, 0x64, 0xb9, 0xe7,
-	0x7a, 0x45, 0xee, 0xb9, 0x51, 0xcc, 0x3d, 0xaf, 0xbe, 0x5e, 0x2e, 0xfa, 0x01, 0x17, 0x7d, 0xc9,
-	0xf6, 0x89, 0x45, 0xa1, 0xb4, 0xec, 0x3f, 0x46, 0xa5, 0xf9, 0x80, 0xa7, 0x27, 0x79, 0x95, 0x5f,
-	0xfc, 0xa2, 0xed, 0x17, 0xdd, 0xac, 0x69, 0xfe, 0xff, 0x1e, 0x95, 0xa4, 0x2c, 0x80, 0xd3, 0xeb,
-	0xdb, 0xdb, 0x1d, 0x5e, 0xc1, 0x20, 0x4d, 0x4a, 0xb5, 0xcd, 0x0a, 0x0a, 0xa1, 0xfc, 0x5c, 0x05,
-	0x05, 0xc7, 0x08, 0xf1, 0x54, 0x13, 0xb4, 0x41, 0x81, 0x41, 0xe1, 0xe7, 0xf9, 0xef, 0xaa, 0x80,
-	0xfe, 0x2d, 0x47, 0x40, 0x9f, 0x63, 0x51, 0x4b, 0xf1, 0x35, 0x54, 0x92, 0x5d, 0x39, 0x4c, 0x0a,
-	0x37, 0xaf, 0x55, 0x7c, 0xfd, 0x72, 0xc9, 0x41, 0xc3, 0xc9, 0xd7, 0x6d, 0xdc, 0x54, 0x38, 0x7e,
-	0xa8, 0xce, 0xca, 0x51, 0x80, 0x95, 0x69, 0x59, 0x8e, 0xb2, 0x88, 0x27, 0x39, 0xd2, 0x48, 0x2a,
-	0x6b, 0x80, 0x2e, 0x30, 0xa9, 0x19, 0x05, 0x26, 0xc1, 0xb0, 0x24, 0x2f, 0x94, 0xbf, 0x17, 0xab,
-	0x92, 0xe4, 0x6d, 0x4b, 0x12, 0xe7, 0x70, 0x5a, 0x92, 0x51, 0x49, 0xb6, 0xa9, 0x40, 0xf0, 0x5a,
-	0x39, 0xc1, 0x47, 0xc8, 0x41, 0xb1, 0x54, 0x77, 0xaf, 0x41, 0xe0, 0x99, 0x8c, 0x86, 0x83, 0x84,
-	0xe7, 0xce, 0x37, 0x6f, 0x70, 0x22, 0x13, 0xd4, 0xdb, 0xbc, 0x01, 0x4a, 0xb9, 0x1a, 0xc7, 0xc3,
-	0x58, 0x5e, 0x63, 0x89, 0x86, 0x2e, 0x50, 0x14, 0x17, 0x59, 0xa2, 0x11, 0xfc, 0x2d, 0x72, 0x65,
-	0xc3, 0x3e, 0x10, 0xf3, 0xae, 0xd8, 0x6c, 0xbe, 0x24, 0x74, 0x71, 0x5a, 0x3b, 0xd9, 0x52, 0xd5,
-	0xdf, 0x29, 0x66, 0xed, 0x0a, 0x5a, 0xaf, 0xd8, 0x88, 0xbf, 0x2c, 0x28, 0x9d, 0x32, 0x3d, 0x82,
-	0x31, 0x94, 0xa6, 0xf3, 0x56, 0x45, 0x1e, 0xd0, 0x19, 0x7c, 0x54, 0x1c, 0xcb, 0xde, 0x41, 0x96,
-	0x23, 0x2d, 0x1d, 0x57, 0x53, 0xff, 0x27, 0x54, 0x9a, 0x67, 0x04, 0xad, 0x8b, 0xcc, 0x98, 0xb8,
-	0x14, 0xab, 0x51, 0xd5, 0x04, 0x8c, 0xb8, 0xe2, 0xed, 0xca, 0x95, 0xa3, 0x9a, 0x10, 0x9c, 0xb5,
-	0x76, 0xe4, 0x61, 0x87, 0x87, 0x9d, 0xa2, 0xc5, 0x83, 0xb6, 0x11, 0x87, 0x8b, 0xa9, 0x95, 0xad,
-	0xaa, 0xfd, 0xf0, 0x57, 0x90, 0xe5, 0x53, 0x4b, 0xb8, 0xd4, 0xa2, 0x7c, 0x17, 0x1d, 0x9e, 0x15,
-	0x7d, 0xec, 0x13, 0x26, 0x2d, 0xe7, 0xef, 0x2b, 0xc8, 0x3a, 0x62, 0x1e, 0x46, 0x5a, 0x33, 0xfa,
-	0x53, 0x54, 0x9e, 0x98, 0xe5, 0x0a, 0x5c, 0x33, 0xe6, 0x5c, 0xb6, 0x0c, 0x05, 0x7a, 0xa6, 0x02,
-	0x33, 0xa6, 0x6b, 0xc6, 0x6e, 0x77, 0xb4, 0xbc, 0x0e, 0xb9, 0x80, 0xbd, 0x36, 0xad, 0x2c, 0x0a,
-	0xf2, 0xda, 0xb4, 0x6a, 0xdb, 0xfe, 0x1a, 0xb2, 0x42, 0x96, 0x32, 0x99, 0xb4, 0xe4, 0x7f, 0x87,
-	0x8a, 0x49, 0xe7, 0x0f, 0x50, 0xe2, 0xaa, 0xf5, 0xfa, 0x75, 0x7b, 0xbd, 0xe6, 0xb9, 0xd4, 0x32,
-	0xfc, 0x73, 0xb6, 0x62, 0x5a, 0x3b, 0x9d, 0xd4, 0x4a, 0x0b, 0x03, 0xcb, 0xdb, 0x61, 0x72, 0x5f,
-	0x5f, 0xa8, 0x8b, 0x56, 0x76, 0xd1, 0xde, 0x95, 0x17, 0x91, 0xb2, 0x05, 0xfe, 0xa4, 0xb5, 0x26,
-	0x05, 0xf1, 0x5a, 0x6b, 0xd0, 0xee, 0x6c, 0xcb, 0x62, 0x25, 0xaf, 0xb3, 0xad, 0x1d, 0x6e, 0xc3,
-	0x70, 0xb8, 0x55, 0x6b, 0xe6, 0x5d, 0xd7, 0x9a, 0x29, 0xf0, 0xa9, 0x85, 0xf9, 0x6f, 0xe4, 0xc8,
-	0xf7, 0x1f, 0x76, 0xae, 0x74, 0xce, 0xca, 0x11, 0xce, 0x95, 0xfc, 0xcc, 0x3c, 0xea, 0x45, 0xa2,
-	0xda, 0x45, 0x56, 0xad, 0x64, 0x00, 0xb2, 0x24, 0x0b, 0xa1, 0xd6, 0x86, 0x7b, 0x83, 0xae, 0x0a,
-	0x21, 0x4d, 0xd0, 0xea, 0x7a, 0xb9, 0xe0, 0xbf, 0x81, 0xac, 0x83, 0x4f, 0x41, 0x26, 0x2d, 0xf2,
-	0x7f, 0x20, 0xe7, 0x5d, 0xc6, 0x13, 0x09, 0xbd, 0x84, 0xa7, 0x0c, 0x73, 0x97, 0x13, 0x69, 0x82,
-	0xc8, 0x4b, 0xb8, 0xc9, 0x6f, 0x2e, 0xb7, 0x87, 0x62, 0x75, 0xc8, 0xaa, 0x00, 0x22, 0xf9, 0xe4,
-	0x38, 0xc1, 0x07, 0xb5, 0x3b, 0xae, 0x5e, 0x2d, 0x17, 0xf6, 0x1b, 0xc8, 0x3a, 0x33, 0x39, 0xa4,
-	0xd1, 0xe2, 0xb6, 0xf1, 0x94, 0x41, 0x04, 0xa6, 0x80, 0x37, 0x8d, 0xf5, 0xa6, 0x01, 0x19, 0x36,
-	0x8b, 0x89, 0x1a, 0x54, 0x03, 0x82, 0xdb, 0xb2, 0x58, 0xc1, 0x59, 0x09, 0xb4, 0x90, 0xaf, 0x04,
-	0x32, 0xaa, 0x80, 0xec, 0x4a, 0x9a, 0x5a, 0xa1, 0x92, 0xe6, 0x7d, 0x0f, 0xcf, 0xd8, 0x95, 0x5d,
-	0x1f, 0x50, 0xa1, 0xd4, 0x87, 0x64, 0x99, 0x11, 0xcb, 0x57, 0x4a, 0x65, 0x72, 0x52, 0xd5, 0x81,
-	0xdc, 0xc0, 0xd3, 0x66, 0x8e, 0x5f, 0x16, 0xea, 0x5d, 0x74, 0x16, 0xa6, 0xad, 0x98, 0x3d, 0x45,
-	0xcd, 0x9f, 0xf5, 0xf1, 0xc2, 0xab, 0xf8, 0x78, 0xa1, 0x8b, 0x59, 0x5b, 0x56, 0x77, 0xd4, 0x96,
-	0x4d, 0x9a, 0xb5, 0x65, 0x5f, 0x42, 0x72, 0xb5, 0xc8, 0x82, 0xe8, 0x6c, 0xaf, 0x56, 0x4a, 0x53,
-	0xcd, 0x2c, 0x51, 0xb5, 0x15, 0x7d, 0x91, 0x49, 0xf7, 0xa3, 0x01, 0x7c, 0xd1, 0xb1, 0x38, 0x62,
-	0xc9, 0xfa, 0x70, 0x4f, 0x5a, 0x70, 0x83, 0x9a, 0x20, 0x5e, 0x00, 0x12, 0x3e, 0x34, 0x96, 0xac,
-	0x6a, 0x06, 0x9f, 0xc3, 0x4d, 0x3a, 0x32, 0x99, 0xd0, 0xcb, 0x04, 0x59, 0xcb, 0x64, 0x55, 0x56,
-	0x1d, 0x41, 0xb7, 0x44, 0x66, 0xd1, 0x89, 0xe9, 0xa4, 0xc5, 0xf7, 0xd4, 0xe8, 0x15, 0x7c, 0x1e,
-	0xe3, 0xd6, 0x9a, 0xf2, 0x5b, 0xd2, 0x51, 0xa2, 0xcc, 0x51, 0x8a, 0x8a, 0xf6, 0x96, 0xac, 0x73,
-	0xe7, 0xbf, 0xc9, 0x0a, 0x1e, 0xa7, 0x23, 0x41, 0xa2, 0x66, 0xd5, 0x13, 0x59, 0x4c, 0x52, 0xd5,
-	0x29, 0xf8, 0x75, 0x84, 0x4f, 0x99, 0x77, 0x97, 0x37, 0x87, 0x61, 0x16, 0xe8, 0x89, 0x5a, 0xf8,
-	0x6d, 0xe8, 0x98, 0x2b, 0x9f, 0xd0, 0x4c, 0xd1, 0xac, 0x4b, 0x95, 0x47, 0xfe, 0x4d, 0xdb, 0x23,
-	0x97, 0x10, 0xd4, 0xeb, 0xf5, 0x1f, 0x91, 0xbb, 0x8c, 0x91, 0x7c, 0x4c, 0x95, 0x81, 0x20, 0xab,
-	0xd8, 0x5b, 0xf7, 0xdd, 0x1c, 0xb1, 0x38, 0x4c, 0x87, 0x71, 0x22, 0xeb, 0x41, 0xc8, 0x35, 0x4c,
-	0x72, 0x23, 0x45, 0x4c, 0x15, 0xea, 0x9c, 0x2a, 0x29, 0x87, 0xa4, 0x8e, 0x4f, 0xac, 0x44, 0x75,
-	0x2d, 0x57, 0x95, 0xab, 0xb7, 0x3c, 0xf1, 0x94, 0x40, 0xb6, 0x82, 0xb7, 0xf0, 0x6c, 0x7e, 0x6c,
-	0xf2, 0x73, 0x78, 0x46, 0xdd, 0x0c, 0xca, 0xaa, 0x18, 0x11, 0x57, 0xe6, 0xa0, 0xb0, 0x97, 0x80,
-	0x81, 0x65, 0xbd, 0xc4, 0x7a, 0xb7, 0x60, 0x60, 0xd6, 0xb7, 0xc3, 0x94, 0xc5, 0xe0, 0x46, 0x54,
-	0x76, 0x36, 0x03, 0x04, 0x6d, 0x7c, 0xc2, 0xa1, 0x18, 0x60, 0xf6, 0xca, 0xdd, 0xbb, 0x9b, 0xa3,
-	0xac, 0xb6, 0x48, 0xb4, 0x94, 0xef, 0x37, 0x8e, 0x82, 0x59, 0x3b, 0x78, 0x1b, 0x2f, 0xba, 0xe6,
-	0xe3, 0x76, 0x94, 0xde, 0x6b, 0xed, 0xd0, 0x11, 0x79, 0x1e, 0xd7, 0x79, 0x99, 0xa9, 0x48, 0x41,
-	0x55, 0x96, 0x99, 0xf2, 0x8e, 0x46, 0x88, 0xec, 0x95, 0x84, 0xc8, 0x35, 0x73, 0xf5, 0x04, 0x9f,
-	0xc3, 0x67, 0x8b, 0x73, 0x62, 0xb1, 0xf0, 0x49, 0xbb, 0xd2, 0xe7, 0xd9, 0x0a, 0x1e, 0xd4, 0x37,
-	0xaa, 0xf6, 0x67, 0x1b, 0x2f, 0xe4, 0x6e, 0x6d, 0xc5, 0x6e, 0x22, 0x4a, 0x88, 0x2e, 0xd9, 0x03,
-	0x2f, 0x99, 0x6b, 0xd6, 0xf5, 0x85, 0x1a, 0x75, 0x88, 0x4f, 0x97, 0xf6, 0x21, 0x1f, 0xc1, 0x8d,
-	0x76, 0x17, 0xb6, 0x4b, 0xa1, 0xb1, 0x79, 0xab, 0x38, 0x15, 0x10, 0xd1, 0x9d, 0x88, 0xc5, 0x54,
-	0x74, 0x22, 0x17, 0x70, 0xd3, 0x28, 0xec, 0xdc, 0x57, 0xc6, 0x60, 0x03, 0x83, 0x5f, 0x45, 0xae,
-	0x72, 0x03, 0xd8, 0x78, 0x74, 0x00, 0x22, 0x0f, 0xb2, 0x06, 0x24, 0x2b, 0x0e, 0x93, 0x0f, 0x02,
-	0xaa, 0x4e, 0x8e, 0xbf, 0x65, 0x9f, 0x1c, 0x8b, 0xc4, 0xf4, 0x12, 0xfe, 0x07, 0x54, 0x5d, 0xe3,
-	0xf0, 0x44, 0x79, 0xfb, 0x43, 0x43, 0x8d, 0xd5, 0x5b, 0xe5, 0xcc, 0x7f, 0x13, 0x59, 0xf7, 0x29,
-	0x55, 0xcc, 0x69, 0x31, 0xfe, 0x0a, 0x95, 0x15, 0x62, 0x3c, 0x25, 0x01, 0x2a, 0xd2, 0x6b, 0xbf,
-	0x2d, 0x04, 0x38, 0x63, 0x9c, 0xa6, 0xab, 0xce, 0x19, 0xdf, 0x43, 0xb8, 0x29, 0x8b, 0x36, 0x62,
-	0x51, 0xca, 0xb6, 0x28, 0x1e, 0x0a, 0x8a, 0x44, 0x85, 0xd8, 0x21, 0x35, 0xc0, 0x28, 0x84, 0x35,
-	0xe3, 0xf3, 0x16, 0xec, 0xbf, 0x9d, 0xb4, 0xdd, 0x15, 0x1b, 0x4a, 0x93, 0x8a, 0x06, 0xb9, 0x84,
-	0x27, 0x95, 0xfb, 0x53, 0x55, 0x9e, 0xbe, 0xb5, 0x32, 0x24, 0x52, 0xbe, 0x9d, 0x54, 0x5d, 0x75,
-	0x4e, 0xa9, 0x61, 0xe6, 0x94, 0xde, 0x43, 0xc5, 0x9a, 0x96, 0x27, 0x52, 0xb0, 0x11, 0x02, 0xd4,
-	0xac, 0x10, 0xa0, 0xea, 0xd8, 0xf3, 0x3b, 0xf6, 0xb1, 0x27, 0xcf, 0x88, 0x56, 0xe9, 0x37, 0x91,
-	0xbb, 0xc8, 0x46, 0xa7, 0x7f, 0x90, 0xf9, 0x3e, 0x75, 0x16, 0xd7, 0x3a, 0xa9, 0x8a, 0x04, 0xe1,
-	0x27, 0xb0, 0x3d, 0x10, 0x67, 0x20, 0x91, 0x27, 0x92, 0xad, 0xaa, 0x54, 0xd9, 0xb7, 0x90, 0x55,
-	0xba, 0xef, 0x22, 0x6f, 0xa6, 0xca, 0x88, 0xc2, 0xb5, 0x98, 0xc8, 0xbc, 0x0e, 0x63, 0x50, 0xe4,
-	0x76, 0xc4, 0xe2, 0x6d, 0x55, 0x12, 0x58, 0xa7, 0x59, 0x5b, 0x6c, 0x33, 0x2c, 0xce, 0x3d, 0x38,
-	0xb1, 0x60, 0x55, 0x5b, 0x5f, 0xf0, 0x6d, 0x0f, 0x1f, 0xcb, 0x79, 0xad, 0x8a, 0x38, 0x2c, 0x7f,
-	0x40, 0xf2, 0x1c, 0x07, 0x24, 0x95, 0x57, 0x69, 0xed, 0xc8, 0xf5, 0xa1, 0x9a, 0x19, 0xa6, 0x93,
-	0xca, 0xe3, 0xa1, 0x6a, 0x1a, 0xe6, 0xd0, 0xc8, 0x5f, 0x5f, 0x8a, 0xfb, 0x48, 0x10, 0x7d, 0x4c,
-	0x44, 0xfa, 0x19, 0xc0, 0x5d, 0x46, 0x8f, 0x9e, 0x42, 0x19, 0x7d, 0x70, 0x0d, 0x37, 0x33, 0xab,
-	0x52, 0x4b, 0x51, 0x87, 0xf2, 0xa8, 0x22, 0x94, 0xf7, 0xac, 0x50, 0x3e, 0x78, 0x07, 0xe1, 0x63,
-	0xdc, 0xb8, 0x8c, 0xe9, 0x35, 0xde, 0x09, 0x20, 0xfb, 0x9d, 0x40, 0x80, 0xa7, 0xad, 0xf7, 0xb3,
-	0x52, 0xdd, 0xd6, 0xe3, 0xd9, 0x55, 0x71, 0xc3, 0xcb, 0x59, 0x93, 0xe5, 0xc0, 0x73, 0xf9, 0x85,
-	0x20, 0x16, 0x71, 0xd6, 0x0c, 0x1e, 0x21, 0x7c, 0xbc, 0xb0, 0xca, 0xcd, 0x3d, 0x0d, 0x1d, 0xbe,
-	0xa7, 0xbd, 0x8c, 0xa7, 0xcd, 0xaf, 0x65, 0x44, 0xac, 0xb6, 0x96, 0xa2, 0x15, 0x53, 0xab, 0x7b,
-	0xf0, 0x6f, 0x48, 0x16, 0x00, 0xd8, 0x7a, 0xb5, 0xa4, 0x41, 0x47, 0x92, 0x86, 0x5c, 0xc2, 0x58,
-	0x9c, 0xd2, 0xb2, 0x17, 0xe6, 0x9a, 0xf9, 0x9c, 0xae, 0xa9, 0xd1, 0x93, 0xbc, 0x82, 0x9b, 0x96,
-	0x12, 0xa4, 0xf6, 0xca, 0xdd, 0xa0, 0xdd, 0xdd, 0x36, 0xce, 0x3a, 0x3f, 0xdc, 0x68, 0x40, 0xd0,
-	0xc7, 0x27, 0xad, 0xee, 0x59, 0x42, 0xba, 0xda, 0x8b, 0x5b, 0x7e, 0xd9, 0x3b, 0xb2, 0x5f, 0x0e,
-	0x7e, 0x84, 0x4a, 0xab, 0x04, 0x9f, 0xf4, 0x8a, 0xdd, 0x32, 0xbd, 0x5a, 0xd1, 0xf4, 0xaa, 0x4e,
-	0x0c, 0xbf, 0x8b, 0x1c, 0x77, 0xec, 0x05, 0xce, 0xac, 0x14, 0x6e, 0x45, 0x1d, 0x63, 0x85, 0x47,
-	0x52, 0x0f, 0x6f, 0x3c, 0xe3, 0xe1, 0xcd, 0xe3, 0xe6, 0x6f, 0x6f, 0x96, 0xcb, 0xf1, 0x6d, 0x64,
-	0x15, 0x09, 0x95, 0xb3, 0x68, 0x5d, 0xbf, 0xaf, 0xf3, 0xb4, 0x4d, 0xd8, 0x8b, 0xd2, 0x83, 0x27,
-	0xb6, 0xea, 0x25, 0x3c, 0x65, 0x0c, 0x23, 0xe5, 0x33, 0x41, 0xc1, 0x9b, 0x78, 0xc1, 0x8c, 0x1f,
-	0x72, 0x34, 0x5d, 0x37, 0x88, 0x2f, 0xe5, 0xc7, 0x34, 0x1f, 0x0c, 0xe6, 0x06, 0xb0, 0x69, 0x7d,
-	0x1e, 0x9f, 0x30, 0x9a, 0x99, 0x2d, 0x7f, 0xc2, 0x8e, 0xad, 0xcf, 0x17, 0x5f, 0x4e, 0xe4, 0x47,
-	0x15, 0xfd, 0x61, 0x6b, 0xbd, 0x1a, 0xab, 0x3b, 0x18, 0xf8, 0x19, 0xfc, 0x04, 0x95, 0x56, 0xaa,
-	0x16, 0x12, 0x29, 0xf6, 0x1b, 0xf1, 0x86, 0xf5, 0xa2, 0x3a, 0x35, 0x2f, 0xbc, 0xd2, 0xe2, 0x8b,
-	0xea, 0x7a, 0xfe, 0x45, 0x75, 0x95, 0x19, 0xbf, 0xe7, 0x4a, 0x45, 0x16, 0xf8, 0xb3, 0x0a, 0x5d,
-	0xf8, 0xc3, 0x72, 0x7e, 0xd6, 0xdf, 0xc9, 0xce, 0xfa, 0x3b, 0xe4, 0x0c, 0xf6, 0x3a, 0xa9, 0xf4,
-	0x4d, 0xb9, 0x97, 0xe8, 0x5e, 0x27, 0x25, 0xcf, 0x67, 0x8f, 0xdd, 0x6a, 0xf6, 0xc9, 0x76, 0xa7,
-	0x93, 0x8a, 0x75, 0x9f, 0xa8, 0x87, 0xb3, 0xbc, 0xb1, 0xb0, 0x25, 0x33, 0x27, 0x49, 0x59, 0xd6,
-	0x65, 0xc5, 0x7e, 0xda, 0x5a, 0xee, 0x43, 0x8c, 0x7c, 0xcc, 0x3b, 0x1e, 0x9e, 0xcd, 0xff, 0xfb,
-	0x03, 0x58, 0x7a, 0x8c, 0x37, 0xba, 0xf2, 0xc1, 0xa0, 0x6a, 0x82, 0x23, 0x63, 0xc6, 0xe5, 0x23,
-	0x5a, 0x6e, 0x50, 0x0d, 0x00, 0xfb, 0x1b, 0x8e, 0xb2, 0x40, 0x89, 0xff, 0x26, 0x67, 0x70, 0x6d,
-	0x94, 0xaa, 0x0c, 0xf7, 0x94, 0x21, 0x23, 0x05, 0x38, 0x0c, 0xb8, 0xbb, 0x17, 0xc7, 0xa0, 0x5b,
-	0xc6, 0xb3, 0xc5, 0x0d, 0xaa, 0x01, 0xe0, 0xc5, 0x46, 0x31, 0x13, 0xc8, 0x31, 0x8e, 0xcc, 0xda,
-	0x20, 0x7f, 0x12, 0xef, 0xf2, 0xdd, 0xbf, 0x4e, 0xe1, 0x27, 0x90, 0xef, 0xb2, 0x24, 0x95, 0x3b,
-	0x3d, 0xff, 0x0d, 0xc7, 0xb0, 0xdd, 0x7b, 0x6c, 0xf7, 0xfe, 0xfa, 0x70, 0x70, 0xa7, 0x17, 0xed,
-	0xa6, 0x72, 0x9b, 0xb7, 0x81, 0xc1, 0x57, 0x91, 0xab, 0x2a, 0x9a, 0x7c, 0x5c, 0x4a, 0x6b, 0x9c,
-	0x93, 0x4b, 0xff, 0x65, 0x84, 0xee, 0x59, 0x75, 0x1a, 0xfb, 0x8e, 0x7d, 0x1a, 0x2b, 0xd2, 0xd4,
-	0x76, 0x05, 0x3c, 0x15, 0x2b, 0xb2, 0x9f, 0x02, 0x4f, 0xdf, 0xb5, 0x79, 0x2a, 0xd2, 0xb4, 0xee,
-	0x41, 0x5c, 0xd5, 0xe0, 0x8f, 0x6b, 0xfa, 0x8b, 0x78, 0x92, 0xef, 0xc9, 0xfc, 0xff, 0x88, 0x08,
-	0x63, 0xd1, 0x00, 0xeb, 0x7f, 0x27, 0x20, 0xfd, 0xdf, 0x20, 0xaa, 0x12, 0xcb, 0xbf, 0xe7, 0x4a,
-	0x2c, 0x5b, 0x2c, 0x6a, 0x19, 0x52, 0x57, 0xdd, 0xba, 0x6d, 0xf2, 0x9e, 0x61, 0xf2, 0x55, 0x9a,
-	0xfb, 0x7d, 0x5b, 0x73, 0xc5, 0x61, 0x35, 0xd5, 0xff, 0x41, 0x87, 0x94, 0xc5, 0x97, 0x3e, 0x03,
-	0x3e, 0x42, 0x7e, 0xc6, 0x9d, 0x78, 0xab, 0xaa, 0x1d, 0x21, 0xb8, 0x3e, 0x30, 0xee, 0xa2, 0xe0,
-	0xf7, 0xea, 0x66, 0xb9, 0xa0, 0xdf, 0x13, 0x82, 0x5e, 0xb0, 0xcb, 0x18, 0xdc, 0x82, 0x68, 0x99,
-	0xff, 0x1a, 0x55, 0xd6, 0xf9, 0x1f, 0x16, 0xa3, 0xc4, 0xd6, 0xcd, 0x85, 0x68, 0xc1, 0x3c, 0x75,
-	0xe3, 0xe1, 0xe8, 0x4a, 0xaf, 0x27, 0xf3, 0xf1, 0xaa, 0x59, 0x55, 0x95, 0xf9, 0x07, 0x82, 0xfd,
-	0xc0, 0xac, 0xbd, 0x3e, 0x8c, 0xf9, 0x37, 0xab, 0x9e, 0x20, 0x54, 0x85, 0x0f, 0x7f, 0x68, 0x87,
-	0x0f, 0xe5, 0x83, 0x68, 0x5a, 0x0f, 0x4b, 0x9e, 0x33, 0x18, 0x51, 0x0d, 0x32, 0xa3, 0x9a, 0xaa,
-	0xaa, 0x89, 0x3f, 0x42, 0xae, 0x8a, 0x13, 0x7b, 0x5c, 0x4d, 0xf9, 0x5f, 0xd0, 0x11, 0x9f, 0x4b,
-	0x94, 0xb1, 0x52, 0x7a, 0xc5, 0x24, 0x43, 0x5e, 0xd8, 0x17, 0xc4, 0x0e, 0x57, 0xa3, 0x1a, 0xb0,
-	0x7a, 0xbb, 0x5c, 0x80, 0xef, 0x0b, 0x01, 0x3e, 0xa2, 0xf5, 0x77, 0x38, 0x77, 0x5a, 0xa0, 0xf7,
-	0xd0, 0xe1, 0x8f, 0x3a, 0x1e, 0x2f, 0x93, 0x57, 0x75, 0x95, 0xfe, 0xc7, 0xf6, 0x55, 0xfa, 0x61,
-	0x84, 0x4d, 0x27, 0xe4, 0x7a, 0x54, 0x02, 0xca, 0x64, 0xfc, 0x3f, 0x09, 0xc9, 0x9c, 0x9f, 0x6c,
-	0x55, 0xb9, 0xbe, 0x3f, 0xb1, 0x5d, 0x9f, 0x63, 0xd4, 0x02, 0xd5, 0xdc, 0x8b, 0x95, 0x27, 0xa1,
-	0xfa, 0x7e, 0x91, 0x6a, 0x6e, 0x54, 0x4d, 0xf5, 0xd7, 0x90, 0xf3, 0x3d, 0x0c, 0x79, 0xc1, 0x7c,
-	0x03, 0x2b, 0xa7, 0xc2, 0xf1, 0xd8, 0xd3, 0xe8, 0x54, 0xc5, 0xd1, 0x0f, 0x6c, 0x8e, 0x1c, 0x04,
-	0x35, 0x47, 0x3d, 0xc7, 0x3b, 0x1c, 0x67, 0xc9, 0x4a, 0xc5, 0xc5, 0xed, 0x9f, 0xda, 0x17, 0xb7,
-	0x85, 0xf1, 0x34, 0xb5, 0x1f, 0xa1, 0xc3, 0xde, 0xf7, 0x3c, 0xf6, 0xe2, 0x32, 0x1e, 0x37, 0xd7,
-	0xac, 0xc7, 0xcd, 0xab, 0x9d, 0x72, 0x8e, 0xff, 0x4c, 0x70, 0xfc, 0x5c, 0xe9, 0xc2, 0x32, 0x59,
-	0xb2, 0x9c, 0x93, 0xf3, 0xe5, 0x51, 0xd9, 0x2b, 0xfc, 0x2a, 0xe7, 0xf4, 0xe7, 0xb6, 0x73, 0x72,
-	0x8e, 0xab, 0x29, 0xff, 0xa2, 0xf3, 0x61, 0x53, 0x95, 0x11, 0xfc, 0x85, 0x6d, 0x04, 0x8e, 0xaf,
-	0xf5, 0xe8, 0x5f, 0x46, 0x65, 0xcf, 0xa3, 0x0a, 0xe1, 0xcc, 0x4c, 0x16, 0xce, 0x34, 0x21, 0x7e,
-	0xa9, 0x4a, 0xf8, 0xfe, 0xa5, 0x9d, 0xf0, 0x75, 0x13, 0xd0, 0x4c, 0xfc, 0x17, 0xaa, 0x78, 0x87,
-	0xf5, 0x94, 0xae, 0xf6, 0x67, 0x71, 0xad, 0xdd, 0x15, 0x09, 0xe0, 0x3a, 0x85, 0x9f, 0xf6, 0x8b,
-	0x81, 0x46, 0xee, 0xc5, 0x40, 0x55, 0xdd, 0xd6, 0x0f, 0xed, 0xba, 0xad, 0x52, 0x49, 0x32, 0x81,
-	0x7f, 0x16, 0x00, 0x00, 0xff, 0xff, 0x47, 0x72, 0x0c, 0x2b, 0x46, 0x50, 0x00, 0x00,
+	// 5366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x4b, 0x8c, 0x1c, 0xc7,
+	0x75, 0xa8, 0x9e, 0x99, 0xdd, 0x99, 0xda, 0x9d, 0xe5, 0xb2, 0xb8, 0x24, 0x9b, 0x2b, 0x92, 0x1a,
+	0xb6, 0xa8, 0x88, 0xb1, 0x65, 0xca, 0x5a, 0xc8, 0xb4, 0xcc, 0x58, 0x92, 0xc9, 0x1d, 0x8a, 0x1c,
+	0x91, 0xcb, 0x1d, 0xd7, 0xae, 0xc5, 0x83, 0x83, 0xc0, 0xbd, 0x3b, 0x45, 0xb2, 0xc5, 0xf9, 0xb9,
+	0xbb, 0x77, 0xc5, 0x35, 0x14, 0x88, 0xb6, 0x0e, 0x01, 0xec, 0x43, 0x10, 0x04, 0x96, 0xe2, 0x00,
+	0x51, 0xe2, 0x58, 0x76, 0xe2, 0x24, 0x4e, 0xe4, 0xfc, 0x1c, 0x24, 0x4e, 0x80, 0x38, 0x09, 0x10,
+	0xe4, 0x90, 0x4b, 0xee, 0x39, 0xe4, 0x9e, 0x20, 0xc9, 0x21, 0x46, 0x6e, 0xc6, 0xab, 0x4f, 0x57,
+	0x55, 0x77, 0x75, 0xef, 0x92, 0x00, 0x75, 0x9a, 0xae, 0xf7, 0x5e, 0x55, 0xbd, 0xf7, 0xaa, 0xea,
+	0xd5, 0xab, 0x57, 0xaf, 0x06, 0xe3, 0x11, 0x4b, 0xc3, 0xf3, 0xd3, 0x78, 0x92, 0x4e, 0x48, 0x83,
+	0xff, 0x04, 0xff, 0x3b, 0x8b, 0xeb, 0xdd, 0x30, 0x0d, 0x09, 0xc1, 0xf5, 0x4d, 0x16, 0x8f, 0x7c,
+	0xd4, 0xf1, 0xce, 0xd5, 0x29, 0xff, 0x26, 0x4b, 0xb8, 0xd1, 0x1b, 0x0f, 0xd8, 0x7d, 0xdf, 0xe3,
+	0x40, 0x51, 0x20, 0x27, 0x71, 0x6b, 0x75, 0xb8, 0x93, 0xa4, 0x2c, 0xee, 0x75, 0xfd, 0x1a, 0xc7,
+	0x68, 0x00, 0x79, 0x1a, 0x37, 0x6e, 0x4e, 0x06, 0x2c, 0xf1, 0xeb, 0x9d, 0xda, 0xb9, 0xb9, 0x95,
+	0x43, 0xa2, 0xbb, 0xf3, 0x00, 0xeb, 0x8d, 0x6f, 0x4f, 0xa8, 0xc0, 0x92, 0xe7, 0x71, 0x0b, 0xba,
+	0xdd, 0x0a, 0x13, 0x96, 0xf8, 0x0d, 0x4e, 0x7a, 0x44, 0x92, 0x2a, 0x38, 0x27, 0xd7, 0x54, 0xd0,
+	0xf2, 0x17, 0x12, 0x16, 0x27, 0xfe, 0x8c, 0xd5, 0x32, 0xc0, 0x44, 0xcb, 0x1c, 0x0b, 0xec, 0xad,
+	0x85, 0xf7, 0x79, 0x7f, 0x5d, 0x7f, 0x56, 0xb0, 0x97, 0x01, 0xc8, 0x39, 0x7c, 0x68, 0x2d, 0xbc,
+	0xbf, 0x71, 0x37, 0x8c, 0x07, 0x57, 0xe3, 0xc9, 0xce, 0xb4, 0xd7, 0xf5, 0x9b, 0x9c, 0x26, 0x0f,
+	0x26, 0xa7, 0x31, 0x56, 0xa0, 0x5e, 0xd7, 0x6f, 0x71, 0x22, 0x03, 0x42, 0x3e, 0x21, 0x24, 0x10,
+	0xc2, 0x62, 0x8b, 0x25, 0x05, 0xa7, 0x9a, 0x02, 0xc8, 0xd7, 0x98, 0x22, 0x9f, 0x73, 0xeb, 0x46,
+	0x53, 0x90, 0x00, 0xcf, 0x4b, 0x9d, 0xf6, 0xd3, 0x9b, 0x3b, 0x23, 0x7f, 0xa1, 0xe3, 0x9d, 0x6b,
+	0x53, 0x0b, 0x46, 0x9e, 0xc3, 0x33, 0xfd, 0xf4, 0xf5, 0x88, 0xbd, 0xe9, 0x1f, 0xe2, 0xed, 0x1d,
+	0x37, 0xba, 0x3f, 0x2f, 0x30, 0x57, 0xc6, 0x69, 0xbc, 0x47, 0x25, 0x19, 0x34, 0xca, 0x6b, 0xf6,
+	0x59, 0x0c, 0xbd, 0xf8, 0x8b, 0x1d, 0x04, 0x8d, 0x9a, 0x30, 0xa9, 0x20, 0x3e, 0xd2, 0x4a, 0x41,
+	0x87, 0x33, 0x05, 0x99, 0x60, 0xa9, 0x20, 0x0e, 0xea, 0x75, 0x7d, 0x92, 0x29, 0x48, 0x42, 0xa0,
+	0xb7, 0xb5, 0xf0, 0xfe, 0x95, 0x5d, 0x36, 0x4e, 0xd7, 0xa7, 0xbd, 0x81, 0x7f, 0xa4, 0x83, 0xce,
+	0xd5, 0xa9, 0x05, 0x83, 0xde, 0x36, 0xc3, 0x7b, 0x6c, 0x7d, 0x97, 0xc5, 0x57, 0xc6, 0xe1, 0xd6,
+	0x90, 0x0d, 0xfc, 0xa5, 0x0e, 0x3a, 0xd7, 0xa4, 0x79, 0x30, 0x79, 0x09, 0xb7, 0xd7, 0xa2, 0x3b,
+	0x71, 0x98, 0x32, 0x5e, 0x3b, 0xf1, 0x8f, 0x5a, 0x32, 0x9b, 0x38, 0xae, 0x4b, 0x9b, 0x1a, 0x3a,
+	0xba, 0x1c, 0x0e, 0xc3, 0xf1, 0xb6, 0xee, 0xe8, 0x98, 0xe8, 0x28, 0x07, 0x96, 0x0a, 0xe8, 0x4e,
+	0xde, 0x1c, 0x6f, 0x84, 0xa3, 0xe9, 0x10, 0x66, 0xd1, 0x71, 0xce, 0x79, 0x1e, 0x4c, 0x3e, 0x8e,
+	0x67, 0x37, 0xd2, 0x98, 0x85, 0xa3, 0xc4, 0xf7, 0x39, 0x33, 0x87, 0x25, 0x33, 0x02, 0xca, 0xd9,
+	0x50, 0x14, 0xa4, 0x83, 0xe7, 0x60, 0xf2, 0x08, 0x4c, 0xd7, 0x3f, 0xc1, 0x9b, 0x34, 0x41, 0x72,
+	0xe2, 0xae, 0x4e, 0xc6, 0xe3, 0xde, 0xc0, 0x5f, 0xe6, 0x78, 0x0d, 0x58, 0x7e, 0x0d, 0xcf, 0x19,
+	0x43, 0x4a, 0x16, 0x71, 0xed, 0x1e, 0xdb, 0xf3, 0x51, 0x07, 0x9d, 0x6b, 0x51, 0xf8, 0x84, 0xe5,
+	0xb1, 0x1b, 0x0e, 0x77, 0x98, 0xef, 0x75, 0x90, 0x39, 0x17, 0x2f, 0xf7, 0x85, 0x42, 0x04, 0xf6,
+	0xa2, 0xf7, 0x22, 0x0a, 0xce, 0xe0, 0xd9, 0x7e, 0xba, 0xfe, 0xe6, 0x98, 0xc5, 0xe4, 0x18, 0x9e,
+	0x91, 0x4b, 0x45, 0x2c, 0x7c, 0x59, 0x0a, 0x86, 0x78, 0x46, 0xd4, 0x23, 0x67, 0x71, 0x83, 0x93,
+	0x72, 0x82, 0xb9, 0x95, 0x05, 0xd9, 0xae, 0x6c, 0x80, 0x36, 0xb2, 0x76, 0x36, 0xd2, 0x30, 0xdd,
+	0x49, 0xb8, 0xad, 0x68, 0x53, 0x59, 0x02, 0xb3, 0xd2, 0x4f, 0x7b, 0x03, 0x6e, 0x27, 0xda, 0x94,
+	0x7f, 0x03, 0xef, 0xaf, 0xb3, 0xd8, 0xaf, 0x73, 0x11, 0xe1, 0x33, 0xf8, 0x04, 0x6e, 0x2a, 0x3e,
+	0xc9, 0x19, 0x5c, 0xef, 0x6e, 0xf5, 0x53, 0x1f, 0x71, 0x95, 0xb6, 0xb3, 0xee, 0xb8, 0x10, 0x1c,
+	0x15, 0x7c, 0x88, 0x70, 0x53, 0x2d, 0x1a, 0xb2, 0x80, 0xbd, 0x8c, 0x7b, 0xaf, 0xd7, 0x85, 0x1e,
+	0xaf, 0x4d, 0x92, 0x94, 0xf3, 0xd1, 0xa2, 0xfc, 0x9b, 0xf8, 0x78, 0x96, 0xf6, 0x57, 0x2f, 0x0d,
+	0x06, 0xb1, 0xdf, 0xe0, 0x1a, 0x53, 0x45, 0xc0, 0x6c, 0xae, 0xf6, 0x79, 0x85, 0x9a, 0xc0, 0xc8,
+	0xa2, 0x21, 0x51, 0xbd, 0xe3, 0x9d, 0xab, 0x65, 0x12, 0x2d, 0xe1, 0xc6, 0x8d, 0xcd, 0x68, 0xc4,
+	0xfc, 0x19, 0x61, 0x14, 0x79, 0x01, 0x16, 0xc3, 0xd5, 0x49, 0x92, 0x44, 0x53, 0xde, 0xc9, 0x2c,
+	0xef, 0xdb, 0x80, 0x04, 0x0c, 0x37, 0x95, 0x2d, 0x20, 0x4f, 0x62, 0xef, 0x66, 0x24, 0xd5, 0x59,
+	0xb0, 0x01, 0xde, 0xcd, 0x08, 0xba, 0xe6, 0xa3, 0xde, 0xe5, 0x63, 0x59, 0xa7, 0xb2, 0x04, 0x73,
+	0xe8, 0xd2, 0x30, 0xda, 0x65, 0x12, 0x59, 0x13, 0x73, 0xc8, 0x00, 0x05, 0x3f, 0x45, 0x78, 0xde,
+	0xb4, 0x9f, 0xa0, 0x8d, 0x9b, 0xe1, 0x88, 0xf1, 0xde, 0x5a, 0x94, 0x7f, 0x93, 0x0b, 0xf8, 0x58,
+	0x97, 0xdd, 0x0e, 0x77, 0x86, 0x29, 0x65, 0x29, 0x1b, 0xa7, 0xd1, 0x64, 0xdc, 0x9f, 0x0c, 0xa3,
+	0xed, 0x3d, 0xa9, 0xb3, 0x12, 0x2c, 0xb9, 0x86, 0x0f, 0xdb, 0xa0, 0x88, 0x25, 0x7e, 0x8d, 0x0f,
+	0xd3, 0xb2, 0x14, 0x23, 0x57, 0x85, 0x4b, 0x54, 0xac, 0x24, 0x16, 0x43, 0x7c, 0xaf, 0xcb, 0x86,
+	0x2c, 0x65, 0x03, 0x3e, 0x26, 0x4d, 0x6a, 0x82, 0xc8, 0x73, 0xb8, 0xc9, 0x0d, 0xed, 0x75, 0xb6,
+	0xe7, 0xcf, 0x74, 0x90, 0xb1, 0x3d, 0x28, 0x30, 0x6f, 0x3b, 0x23, 0x0a, 0x7e, 0x0d, 0xe1, 0x23,
+	0xb9, 0xde, 0x37, 0xa6, 0x6c, 0xdb, 0x50, 0x00, 0xca, 0x14, 0xb0, 0x8c, 0x9b, 0xdd, 0x9d, 0x38,
+	0x04, 0x4a, 0xae, 0xe1, 0x1a, 0xcd, 0xca, 0xe4, 0x3c, 0x26, 0x7a, 0x1b, 0xc8, 0xa8, 0x6a, 0x9c,
+	0xca, 0x81, 0x81, 0xb6, 0x28, 0x9b, 0x0e, 0xa3, 0xed, 0xf0, 0x26, 0x9f, 0xd1, 0x6d, 0x9a, 0x95,
+	0x83, 0x57, 0xf0, 0xac, 0x64, 0x34, 0x9b, 0xa5, 0x48, 0xce, 0xd2, 0x45, 0x5c, 0xa3, 0xec, 0x36,
+	0xef, 0xbd, 0x41, 0xe1, 0x93, 0x6f, 0xc0, 0x7b, 0x53, 0xc6, 0xbb, 0x6a, 0x50, 0xfe, 0x1d, 0xbc,
+	0xdf, 0xc0, 0x87, 0xd6, 0x58, 0x98, 0xec, 0xc4, 0x6c, 0x24, 0x0d, 0x9b, 0x73, 0x44, 0x9f, 0xc7,
+	0x2d, 0xa5, 0x08, 0x58, 0x80, 0xb5, 0x32, 0x75, 0x69, 0x2a, 0x72, 0x11, 0xcf, 0x6c, 0x6c, 0xdf,
+	0x65, 0xa3, 0x50, 0x8e, 0x60, 0xa0, 0x0c, 0xa9, 0xdd, 0xdd, 0x79, 0x41, 0x24, 0xf7, 0x11, 0x51,
+	0xc8, 0x0f, 0x5f, 0xbd, 0x38, 0x7c, 0x17, 0x71, 0x3b, 0x82, 0x6d, 0x80, 0xb2, 0xa1, 0x50, 0x60,
+	0x83, 0x8f, 0xe1, 0x92, 0xec, 0xa4, 0x67, 0xe2, 0xa8, 0x4d, 0x0a, 0xaa, 0xd9, 0xdc, 0xbc, 0xc1,
+	0x47, 0xbd, 0x46, 0xe1, 0x93, 0xac, 0xe0, 0xa5, 0x2b, 0x49, 0x1a, 0x8d, 0xc2, 0x94, 0x0d, 0x56,
+	0xc3, 0x78, 0x10, 0x8d, 0xc3, 0x61, 0x94, 0xee, 0xf9, 0xb3, 0x5c, 0x9d, 0x4e, 0x1c, 0x58, 0xd3,
+	0x2f, 0x4c, 0x07, 0x00, 0xbd, 0x94, 0xfa, 0x4d, 0xde, 0x96, 0x06, 0x90, 0x67, 0x8d, 0xa9, 0x0c,
+	0xbb, 0x4c, 0x1c, 0x0d, 0x98, 0xdf, 0xe2, 0x54, 0x45, 0x04, 0x79, 0x09, 0xcf, 0x5e, 0x1a, 0x46,
+	0x61, 0x92, 0x6d, 0xf4, 0x4f, 0x95, 0x28, 0x4b, 0x52, 0x09, 0x6d, 0xa9, 0x3a, 0x84, 0x62, 0xa2,
+	0xf7, 0x8d, 0x6c, 0xe1, 0xcc, 0x95, 0xa9, 0x3d, 0x47, 0xbb, 0x47, 0x1d, 0xb5, 0x97, 0x7b, 0x78,
+	0xce, 0x18, 0x19, 0xc7, 0x76, 0x70, 0xd6, 0xde, 0x0e, 0x94, 0xd9, 0x56, 0x33, 0x41, 0xef, 0x06,
+	0xcb, 0x17, 0xf1, 0xbc, 0xc9, 0xb7, 0xa3, 0xad, 0x25, 0xb3, 0xad, 0x96, 0xb9, 0x93, 0xbc, 0x8b,
+	0xf0, 0x13, 0x15, 0xac, 0xc3, 0xea, 0xe8, 0x8d, 0x53, 0x16, 0xef, 0x86, 0x43, 0x3e, 0x61, 0x6b,
+	0x34, 0x2b, 0x93, 0xe7, 0x71, 0x63, 0x35, 0x1c, 0x0e, 0xd5, 0x84, 0x7d, 0x42, 0x6d, 0x58, 0x59,
+	0x1b, 0xaf, 0x46, 0x6c, 0x38, 0xe0, 0x24, 0x54, 0x50, 0xc2, 0xde, 0xdc, 0x65, 0x49, 0x6a, 0xf4,
+	0xc8, 0x37, 0x96, 0x16, 0xcd, 0x83, 0x83, 0x2e, 0x5e, 0x72, 0x35, 0x04, 0xa2, 0xf0, 0x92, 0x5c,
+	0x3e, 0xa2, 0x00, 0x06, 0xf7, 0xd2, 0x9d, 0x3b, 0xeb, 0x53, 0xc1, 0x4b, 0x8b, 0xca, 0x52, 0xf0,
+	0x7f, 0x8d, 0x82, 0x51, 0x29, 0x5d, 0x83, 0xb6, 0x51, 0xf1, 0x0e, 0x64, 0x54, 0xbc, 0x03, 0x19,
+	0x15, 0xcf, 0x34, 0x2a, 0xe4, 0x22, 0x9e, 0x37, 0x04, 0x55, 0xce, 0xf3, 0x31, 0xf7, 0x8c, 0xa4,
+	0x16, 0x2d, 0x59, 0xc3, 0x73, 0x6b, 0x49, 0xfa, 0x3a, 0x8b, 0x93, 0x68, 0x32, 0x4e, 0xfc, 0x05,
+	0x5e, 0xf5, 0xe3, 0xe5, 0xb6, 0xfb, 0xbc, 0x41, 0x2d, 0x26, 0xb5, 0x59, 0x9f, 0x7c, 0x1a, 0xcf,
+	0x69, 0xe6, 0x95, 0x5f, 0x7e, 0xd4, 0x34, 0x3c, 0x1c, 0xc3, 0x19, 0x31, 0x29, 0xc1, 0x99, 0xdb,
+	0xd8, 0xd9, 0x4a, 0xb6, 0xe3, 0x68, 0x9a, 0x72, 0x4e, 0x66, 0x2d, 0x67, 0xce, 0xc4, 0x09, 0x67,
+	0xce, 0xa2, 0xce, 0xdb, 0x9f, 0x66, 0xd1, 0xfe, 0x74, 0xf0, 0xdc, 0xb5, 0x49, 0x9a, 0x69, 0xba,
+	0xc5, 0x35, 0x6d, 0x82, 0xc0, 0x3b, 0xbd, 0x15, 0xc6, 0xa3, 0x8c, 0x04, 0x73, 0x12, 0x0b, 0x06,
+	0xc3, 0xa6, 0x3d, 0xde, 0x8c, 0x72, 0x4e, 0x0c, 0x5b, 0x11, 0x03, 0xfa, 0xd0, 0xd0, 0xc4, 0x9f,
+	0xb7, 0xf4, 0xa1, 0x31, 0x42, 0x1f, 0x06, 0x25, 0x59, 0x37, 0x67, 0xab, 0x56, 0xbf, 0xdf, 0xee,
+	0x20, 0xe7, 0xca, 0xd0, 0x24, 0xd4, 0x59, 0x71, 0xf9, 0x65, 0xbc, 0x98, 0x1f, 0xba, 0xfd, 0xd6,
+	0x75, 0xdb, 0x5c, 0xd7, 0x3f, 0x41, 0x78, 0xc1, 0x1e, 0xc0, 0x82, 0x9f, 0x75, 0x12, 0xb7, 0x36,
+	0xd2, 0x30, 0x4e, 0xb9, 0x2f, 0x24, 0x26, 0xbc, 0x06, 0x80, 0x5f, 0x75, 0x65, 0x3c, 0xe0, 0x38,
+	0x31, 0xcd, 0x55, 0x11, 0xea, 0xc9, 0x51, 0xba, 0x94, 0x4a, 0xd7, 0x4a, 0x03, 0xc8, 0x39, 0x3c,
+	0xc3, 0xfb, 0x55, 0xf3, 0x7a, 0xd1, 0x9c, 0x4d, 0x5c, 0x60, 0x89, 0x87, 0x21, 0xde, 0x8c, 0x77,
+	0xc6, 0xdb, 0xd2, 0xc4, 0x8b, 0xed, 0xc2, 0x04, 0x05, 0xef, 0x79, 0xb8, 0x95, 0xd5, 0x2b, 0xf0,
+	0x7f, 0x1a, 0x37, 0xb9, 0xeb, 0xda, 0xeb, 0x8a, 0x55, 0xdf, 0xbe, 0xec, 0xf9, 0x88, 0x66, 0x30,
+	0x50, 0xd7, 0x5a, 0x34, 0x96, 0xf6, 0x05, 0x3e, 0x39, 0x24, 0xbc, 0xef, 0xd7, 0x25, 0x24, 0xbc,
+	0xcf, 0xf7, 0xec, 0x88, 0x81, 0x53, 0x29, 0x0e, 0xcd, 0x11, 0xe3, 0x1e, 0xa5, 0x3a, 0x13, 0x09,
+	0x0f, 0x51, 0x15, 0xb9, 0xf5, 0xca, 0x06, 0xeb, 0x06, 0xdb, 0x65, 0x43, 0xee, 0x28, 0xd6, 0x68,
+	0x1e, 0x0c, 0x93, 0xd3, 0x3a, 0x80, 0x34, 0xc5, 0xd1, 0xc9, 0x84, 0x09, 0x1b, 0x11, 0x0e, 0xd6,
+	0xc7, 0xc3, 0x3d, 0xbe, 0x73, 0x35, 0x69, 0x56, 0x16, 0x47, 0x33, 0xb5, 0x1a, 0x7c, 0xcc, 0xb1,
+	0x06, 0x24, 0xa0, 0x78, 0xde, 0xf4, 0x0b, 0xa0, 0x2d, 0x55, 0xe6, 0x7e, 0x77, 0x4b, 0x3b, 0x56,
+	0x99, 0x5f, 0x22, 0x6c, 0x3f, 0xff, 0x06, 0xd8, 0xc6, 0x9d, 0xcc, 0x03, 0xe5, 0xdf, 0xc1, 0x2f,
+	0xe1, 0xc5, 0xfc, 0xba, 0x75, 0xda, 0x49, 0x82, 0xeb, 0x6b, 0x93, 0x81, 0x98, 0x32, 0x2d, 0xca,
+	0xbf, 0xb9, 0xbc, 0x2c, 0x49, 0xa3, 0x71, 0x28, 0xcc, 0x41, 0x8d, 0xf3, 0x60, 0xc1, 0x82, 0xb3,
+	0x18, 0x73, 0x9e, 0xaa, 0xcf, 0x2d, 0xef, 0x22, 0xdc, 0x54, 0x11, 0x81, 0xb2, 0xee, 0xaf, 0x85,
+	0xc9, 0xdd, 0xec, 0x78, 0x10, 0x26, 0x77, 0x61, 0x1d, 0x5c, 0x1a, 0x8c, 0xe4, 0x60, 0x37, 0xa9,
+	0x28, 0x40, 0x17, 0xf4, 0x4d, 0x68, 0x4b, 0x3a, 0x38, 0xb2, 0x44, 0x5e, 0xc0, 0xb8, 0x1f, 0x47,
+	0xbb, 0xd1, 0x90, 0xdd, 0xc9, 0x62, 0x17, 0x4b, 0x46, 0x30, 0x22, 0x43, 0x52, 0x83, 0x2e, 0xe8,
+	0xe1, 0xb6, 0x85, 0xe4, 0xfb, 0x85, 0xf4, 0xd4, 0x25, 0x83, 0x59, 0x19, 0xd6, 0x48, 0x46, 0xc8,
+	0x39, 0x6d, 0x50, 0x0d, 0x08, 0xde, 0x41, 0xb8, 0xdd, 0xcb, 0xbb, 0x4c, 0x34, 0x12, 0x7b, 0x5a,
+	0x9b, 0xc2, 0x27, 0x40, 0xd6, 0xa3, 0x81, 0x98, 0xd8, 0x14, 0x3e, 0xa1, 0x4d, 0x5e, 0x89, 0x6b,
+	0x44, 0x28, 0x58, 0x03, 0xc8, 0x27, 0x31, 0xe6, 0x85, 0x1b, 0x51, 0x92, 0xaa, 0xd8, 0xcd, 0xa2,
+	0x69, 0xb9, 0x00, 0x41, 0x0d, 0x9a, 0xe0, 0x0c, 0x6e, 0x65, 0x25, 0x1e, 0x29, 0x82, 0x0f, 0x39,
+	0x7b, 0x44, 0x21, 0x18, 0x60, 0x9f, 0x4e, 0xcd, 0x0d, 0x88, 0xef, 0xb6, 0x09, 0x1f, 0x9b, 0x6b,
+	0x78, 0x31, 0xb7, 0x57, 0x25, 0xf2, 0xc8, 0x77, 0xb2, 0xb8, 0x95, 0xe9, 0x7a, 0xb4, 0x50, 0x2b,
+	0x98, 0xe0, 0xa3, 0x4e, 0x52, 0x58, 0x89, 0x6b, 0x49, 0x6a, 0xcc, 0x00, 0x55, 0x24, 0x9f, 0xc5,
+	0x18, 0xe6, 0xb1, 0xa0, 0xf5, 0xbd, 0xb2, 0x6e, 0x35, 0x0d, 0x35, 0xe8, 0x83, 0x55, 0xab, 0x43,
+	0x8d, 0x80, 0x19, 0x23, 0x9b, 0x14, 0x6a, 0x90, 0x25, 0x63, 0x09, 0xc1, 0x6a, 0xe7, 0xdf, 0xc1,
+	0x37, 0x3c, 0x8c, 0x75, 0x9c, 0xc0, 0x39, 0x55, 0x85, 0xc5, 0xf2, 0x32, 0x8b, 0xf5, 0x02, 0x9e,
+	0xd9, 0x88, 0xb7, 0xd7, 0x12, 0xe1, 0xf4, 0x68, 0x8e, 0x45, 0x33, 0xf9, 0x9d, 0x5f, 0xd2, 0x42,
+	0xad, 0x2e, 0x4b, 0xa0, 0x56, 0xfd, 0x20, 0xb5, 0x04, 0xad, 0xe5, 0xb8, 0x35, 0x72, 0x8e, 0xdb,
+	0x12, 0x6e, 0x74, 0xd9, 0x30, 0xdc, 0xe3, 0xf6, 0xad, 0x46, 0x45, 0x01, 0x24, 0xe8, 0x46, 0x23,
+	0xb1, 0x95, 0xb7, 0x28, 0xff, 0x26, 0xcf, 0x28, 0x17, 0xaf, 0xe9, 0x88, 0x8f, 0x00, 0x46, 0x3a,
+	0x76, 0xc1, 0x05, 0x3c, 0xa7, 0x95, 0xc1, 0xeb, 0x99, 0x33, 0xc2, 0x11, 0x57, 0x11, 0xf8, 0xe0,
+	0xcb, 0xf8, 0xa8, 0x53, 0x8e, 0x52, 0x0f, 0x4d, 0xad, 0x38, 0x2f, 0xb7, 0xe2, 0xce, 0xe1, 0x43,
+	0xf9, 0xc3, 0xb0, 0xf4, 0x2c, 0x73, 0xe0, 0xe0, 0x86, 0x1a, 0x37, 0xe0, 0x1c, 0xfa, 0x81, 0x5f,
+	0xd5, 0x0f, 0x87, 0x65, 0x3e, 0xa6, 0x67, 0xfa, 0x98, 0x60, 0x64, 0xc0, 0xcd, 0x96, 0xed, 0x8a,
+	0x42, 0xf0, 0xed, 0x36, 0x9e, 0x5d, 0x9d, 0x8c, 0x46, 0xe1, 0x78, 0x40, 0x9e, 0xc1, 0xf5, 0x14,
+	0xa6, 0x09, 0xb4, 0xb5, 0x90, 0x1d, 0xe0, 0x24, 0xf6, 0x3c, 0xcc, 0x1a, 0xca, 0x09, 0x82, 0xff,
+	0x98, 0x17, 0x13, 0x8a, 0x9c, 0xc0, 0x47, 0x57, 0x63, 0x16, 0xa6, 0x4c, 0xc9, 0x21, 0x89, 0x17,
+	0x6b, 0xe4, 0x38, 0x3e, 0xd2, 0x8d, 0x27, 0xd3, 0x3c, 0xa2, 0x4e, 0x3a, 0xf8, 0xa4, 0xa8, 0x93,
+	0x13, 0x4c, 0x51, 0x34, 0xc8, 0x69, 0xbc, 0x0c, 0x55, 0x4b, 0xf0, 0x33, 0xe4, 0x2c, 0xee, 0x6c,
+	0xb0, 0xd4, 0x1d, 0x24, 0x50, 0x54, 0xb3, 0xd0, 0x8f, 0x38, 0x6f, 0x95, 0x50, 0x34, 0xc9, 0x13,
+	0xf8, 0xb8, 0xe0, 0x44, 0x7b, 0x1a, 0x0a, 0xd9, 0x02, 0xa4, 0xd8, 0xac, 0x8a, 0x48, 0x4c, 0x8e,
+	0xe2, 0xc3, 0xa2, 0x26, 0x98, 0x54, 0x05, 0x6e, 0x93, 0x23, 0xf8, 0x10, 0x30, 0x6e, 0x02, 0x17,
+	0x80, 0x56, 0xf0, 0x61, 0x82, 0x0f, 0x81, 0x7e, 0x36, 0x58, 0x9a, 0x19, 0x55, 0x85, 0x58, 0x24,
+	0x04, 0x2f, 0x80, 0x74, 0x61, 0x1a, 0x2a, 0xd8, 0x61, 0x72, 0x12, 0xfb, 0x1b, 0x2c, 0xe5, 0xdb,
+	0x42, 0xa1, 0x06, 0x21, 0xa7, 0xf0, 0x09, 0x29, 0x87, 0xb1, 0xff, 0x29, 0xf4, 0x51, 0x2e, 0x49,
+	0x3c, 0x99, 0xba, 0x90, 0xc7, 0xf4, 0x08, 0xaa, 0xd0, 0xaf, 0x42, 0xf9, 0xf6, 0xe0, 0x9a, 0xa8,
+	0x13, 0x80, 0x12, 0x32, 0xe5, 0x51, 0xcb, 0x80, 0x12, 0x7a, 0xcb, 0x37, 0xf8, 0x84, 0x46, 0xe5,
+	0x6b, 0x9d, 0x24, 0xc7, 0x30, 0xd9, 0x60, 0x69, 0xbe, 0xca, 0x29, 0xb2, 0x84, 0x17, 0x39, 0xef,
+	0x30, 0x06, 0x0a, 0x7a, 0x1a, 0x04, 0xe6, 0xce, 0x84, 0x9c, 0x5b, 0xa2, 0x51, 0x85, 0x7e, 0x12,
+	0x04, 0x16, 0xdc, 0xe9, 0xfd, 0x5a, 0x21, 0x9f, 0x82, 0xc9, 0x03, 0x75, 0x73, 0x93, 0xc2, 0x6e,
+	0xe2, 0x19, 0x50, 0xb8, 0x52, 0x4b, 0xb6, 0xae, 0x15, 0xf6, 0x79, 0xe0, 0xea, 0xd2, 0x30, 0x65,
+	0xb1, 0xf2, 0x51, 0x56, 0x47, 0x83, 0xc5, 0x15, 0x18, 0x68, 0x2a, 0xba, 0x8c, 0xc6, 0x77, 0x14,
+	0xf1, 0x0b, 0x30, 0xd0, 0x92, 0x1b, 0x7e, 0x5e, 0x56, 0x88, 0x4f, 0x01, 0x82, 0xb2, 0xe9, 0x24,
+	0x4e, 0x79, 0x9d, 0x44, 0x21, 0x2e, 0x80, 0x32, 0xfa, 0xf1, 0xce, 0x98, 0x09, 0xe7, 0x5c, 0xc1,
+	0x3f, 0x03, 0x33, 0x1a, 0x58, 0x37, 0xcf, 0xbb, 0x16, 0xdb, 0x17, 0xc9, 0x32, 0x3e, 0x06, 0xea,
+	0x72, 0x30, 0xfd, 0x0b, 0xc0, 0x34, 0xf8, 0xbf, 0x34, 0x1c, 0xeb, 0xb9, 0xf3, 0x59, 0xe2, 0xe3,
+	0x25, 0xde, 0xbd, 0x3a, 0x43, 0x28, 0xcc, 0x4b, 0x7a, 0x01, 0xe8, 0x83, 0x82, 0x42, 0xbe, 0x0c,
+	0x4b, 0xd4, 0x50, 0x31, 0x58, 0x3c, 0xf0, 0x3d, 0x15, 0xfe, 0x15, 0x3d, 0x04, 0x30, 0x9c, 0x22,
+	0x70, 0xa9, 0x90, 0x9f, 0x03, 0xf9, 0x84, 0x72, 0x79, 0x6c, 0x5c, 0xc1, 0x2f, 0x01, 0x5c, 0x54,
+	0xb2, 0xe0, 0x97, 0xb5, 0x06, 0x45, 0x10, 0x56, 0x21, 0x56, 0xa1, 0x02, 0x65, 0xa3, 0xc9, 0xae,
+	0x5d, 0xa1, 0x4b, 0xce, 0xe0, 0x53, 0x72, 0xe6, 0xe6, 0xce, 0x26, 0x8a, 0xe4, 0x0a, 0x79, 0x12,
+	0x3f, 0xc1, 0xcd, 0x53, 0x09, 0xc1, 0xab, 0x20, 0xe1, 0x55, 0x96, 0x96, 0xe1, 0xaf, 0x1a, 0xab,
+	0x63, 0x4b, 0xc4, 0xc5, 0x15, 0xea, 0x1a, 0xf9, 0x79, 0xfc, 0xf4, 0x55, 0x96, 0xe6, 0x76, 0x84,
+	0x5b, 0x51, 0x7a, 0x37, 0x82, 0xb6, 0x18, 0xcd, 0xf4, 0xd8, 0x83, 0xd9, 0x68, 0xe8, 0x51, 0xf7,
+	0x66, 0xca, 0xf9, 0x1a, 0x28, 0x00, 0x06, 0x1e, 0xae, 0x24, 0x26, 0xbb, 0x5a, 0xcd, 0xd7, 0x15,
+	0x42, 0x5d, 0x21, 0x28, 0xc4, 0x0d, 0x40, 0x48, 0x93, 0x20, 0xb6, 0x0a, 0x89, 0x58, 0x83, 0x49,
+	0xca, 0x17, 0x94, 0x05, 0xbe, 0x49, 0x02, 0x7c, 0xba, 0xc8, 0xf2, 0x46, 0x3a, 0x89, 0xb3, 0xa9,
+	0xb2, 0x0e, 0x12, 0xbf, 0xce, 0xe2, 0xe8, 0xf6, 0x5e, 0x7e, 0xf9, 0xf6, 0xa1, 0xbb, 0x2b, 0xf7,
+	0xa7, 0xe1, 0x78, 0x60, 0x4f, 0xd9, 0xcf, 0xc3, 0x84, 0x54, 0x43, 0x27, 0x0f, 0x83, 0x0a, 0x47,
+	0x61, 0x15, 0x9b, 0x0b, 0xe3, 0x72, 0x94, 0x8e, 0xc2, 0x4c, 0x35, 0x1b, 0x1f, 0x6b, 0x36, 0x07,
+	0x8b, 0x0f, 0x1e, 0x3c, 0x78, 0xe0, 0x05, 0x0f, 0xbc, 0x92, 0x6d, 0xc6, 0xb9, 0xcb, 0x76, 0x8b,
+	0x3b, 0xa9, 0x08, 0x41, 0x55, 0xc5, 0x88, 0xf3, 0x55, 0xe0, 0x04, 0xa3, 0x22, 0x1e, 0x3b, 0x23,
+	0x7e, 0xce, 0x68, 0x53, 0x03, 0x42, 0x9e, 0xc6, 0xb5, 0x8d, 0x7b, 0x11, 0xf7, 0xcc, 0x4b, 0x62,
+	0x9d, 0x80, 0x5f, 0x79, 0x15, 0xcf, 0x6e, 0x4b, 0x5e, 0x17, 0xec, 0xfd, 0xd4, 0xbf, 0xd3, 0x41,
+	0x86, 0x37, 0xe4, 0x94, 0x8f, 0xaa, 0xca, 0xc1, 0xc4, 0xb9, 0x9b, 0xba, 0xe4, 0x5f, 0xe9, 0x96,
+	0x77, 0x79, 0xd7, 0xd2, 0x83, 0xa3, 0x41, 0xdd, 0xe1, 0x7f, 0xa2, 0xea, 0x6d, 0xba, 0xf2, 0xf8,
+	0xe0, 0x1c, 0x02, 0xef, 0x61, 0x87, 0x80, 0x1f, 0xd4, 0xc5, 0x1e, 0xdf, 0x97, 0x27, 0x23, 0x0d,
+	0x58, 0x59, 0x2b, 0x17, 0x33, 0xea, 0x20, 0x23, 0x46, 0x5a, 0x25, 0x85, 0x96, 0xf7, 0x5b, 0xa8,
+	0xca, 0xe9, 0xa8, 0x94, 0x56, 0x0d, 0x82, 0x67, 0x0c, 0xc2, 0xf5, 0x72, 0xee, 0xde, 0xe0, 0xdc,
+	0x9d, 0x31, 0x06, 0x61, 0x3f, 0xde, 0xbe, 0x8b, 0xf6, 0x77, 0x78, 0x1e, 0x9a, 0xc3, 0xcf, 0x97,
+	0x73, 0x78, 0x8f, 0x73, 0xf8, 0x8c, 0x9a, 0xd4, 0xfb, 0xf4, 0xac, 0xf9, 0xfc, 0x51, 0xad, 0xda,
+	0xe5, 0x7a, 0x58, 0x1e, 0xe1, 0x00, 0x75, 0x93, 0xbd, 0x29, 0x0f, 0x8c, 0xfc, 0x72, 0x4c, 0x16,
+	0xad, 0x60, 0x67, 0x3d, 0x77, 0x83, 0x62, 0x06, 0x2f, 0x1b, 0xf6, 0x8d, 0x48, 0x49, 0x20, 0x74,
+	0xa6, 0xf4, 0x76, 0x85, 0x47, 0xfa, 0xee, 0x31, 0xa9, 0x00, 0x1e, 0x2e, 0x69, 0x52, 0x13, 0x54,
+	0x8c, 0xf4, 0xa1, 0xfd, 0x23, 0x7d, 0xe8, 0xc0, 0x91, 0x3e, 0xe4, 0x8e, 0xf4, 0x55, 0xcd, 0xfe,
+	0xa1, 0x35, 0xfb, 0xab, 0xc6, 0x43, 0x8f, 0xdc, 0xbf, 0xa1, 0x52, 0x57, 0xb8, 0x72, 0xd0, 0x8e,
+	0xe1, 0x19, 0xeb, 0xe6, 0x6e, 0x46, 0x2f, 0x5d, 0xf0, 0x35, 0x92, 0x34, 0x1c, 0x4d, 0x65, 0xfc,
+	0x4d, 0x03, 0x00, 0xcb, 0xbb, 0xe1, 0xa1, 0xab, 0xba, 0xc8, 0x90, 0xc8, 0x00, 0x2b, 0xd7, 0xca,
+	0x45, 0x1b, 0x71, 0xd1, 0x4e, 0x5b, 0x0b, 0xbb, 0xc0, 0xb0, 0x96, 0xea, 0x6f, 0x50, 0xa9, 0x0f,
+	0xff, 0x48, 0x52, 0x05, 0x78, 0x5e, 0x37, 0x94, 0xe5, 0x9e, 0x58, 0xb0, 0x2a, 0xee, 0xc7, 0x16,
+	0xf7, 0x25, 0x8c, 0x69, 0xee, 0x7f, 0x80, 0x1c, 0x87, 0x8c, 0xc7, 0x13, 0x52, 0x5a, 0xb9, 0x5c,
+	0xce, 0xf5, 0x97, 0x39, 0xd7, 0xbe, 0xa5, 0x73, 0x83, 0x21, 0xcd, 0xef, 0x9d, 0xc2, 0xe1, 0xc7,
+	0xb9, 0x3d, 0x7d, 0xae, 0xbc, 0xab, 0xb8, 0x83, 0x8c, 0x9b, 0x84, 0x5c, 0x63, 0xba, 0xa3, 0xb7,
+	0x1d, 0x07, 0xaa, 0x83, 0xea, 0xa5, 0x4a, 0xd2, 0xc4, 0x92, 0xb4, 0xd0, 0x85, 0x66, 0xe0, 0x87,
+	0xc8, 0x79, 0x76, 0x83, 0x39, 0x05, 0xf4, 0x63, 0xcd, 0x47, 0x56, 0xae, 0x3c, 0xfb, 0x5b, 0xd1,
+	0xb6, 0x5a, 0x2e, 0xda, 0x56, 0xb5, 0x9f, 0xa7, 0xd6, 0x7e, 0xee, 0x60, 0x49, 0xf3, 0x1c, 0xe7,
+	0x4f, 0x95, 0xe4, 0x49, 0x91, 0x78, 0x25, 0xf3, 0x00, 0xe6, 0x8c, 0xdc, 0x1d, 0xca, 0x11, 0x2b,
+	0xaf, 0x94, 0x77, 0xbc, 0xd3, 0x41, 0xc6, 0xcd, 0x82, 0xdd, 0xb0, 0xee, 0xf3, 0x3d, 0x54, 0x7e,
+	0x6c, 0xad, 0x54, 0x56, 0x36, 0x79, 0x3d, 0x63, 0xf2, 0xae, 0xf4, 0xca, 0xf9, 0xd9, 0xe5, 0xfc,
+	0x3c, 0xa9, 0xf9, 0x71, 0xf6, 0xa9, 0x39, 0xfb, 0x7f, 0x54, 0x71, 0x64, 0x7e, 0x7c, 0xb1, 0x9b,
+	0x2c, 0xf6, 0x5c, 0xaf, 0x88, 0x3d, 0x37, 0x8a, 0xb1, 0xe7, 0x95, 0xd7, 0xca, 0x45, 0xdf, 0xe3,
+	0xa2, 0x77, 0x6c, 0x9b, 0x58, 0x14, 0x4a, 0xcb, 0xfe, 0xb7, 0xa8, 0x34, 0x1e, 0xf0, 0xf8, 0x24,
+	0xaf, 0xb2, 0x8b, 0x5f, 0xb1, 0xed, 0xa2, 0x9b, 0x35, 0xcd, 0xff, 0x3f, 0xa0, 0x92, 0x90, 0x05,
+	0x70, 0x7a, 0x6d, 0x73, 0xb3, 0xcf, 0x33, 0x60, 0xe4, 0x94, 0x52, 0x65, 0x33, 0x03, 0x47, 0x28,
+	0x3f, 0x97, 0x81, 0xc3, 0x31, 0x42, 0x3c, 0x55, 0x04, 0x6d, 0x50, 0x60, 0x50, 0xd8, 0x79, 0xfe,
+	0x5d, 0xe5, 0xd0, 0xbf, 0xe5, 0x70, 0xe8, 0x73, 0x2c, 0x6a, 0x29, 0xbe, 0x89, 0x4a, 0xa2, 0x2b,
+	0xfb, 0x49, 0xe1, 0xe6, 0xb5, 0x8a, 0xaf, 0x5f, 0x2e, 0x39, 0x68, 0x38, 0xf9, 0xba, 0x85, 0xdb,
+	0x0a, 0xc7, 0x0f, 0xd5, 0x59, 0x3a, 0x13, 0xb0, 0x32, 0x2f, 0xd3, 0x99, 0x4e, 0xe2, 0x16, 0x47,
+	0x1a, 0x41, 0x65, 0x0d, 0xd0, 0x09, 0x4a, 0x35, 0x23, 0x41, 0x09, 0xa2, 0xe4, 0xce, 0xb8, 0x50,
+	0xfe, 0x5e, 0xac, 0x4a, 0x92, 0xb7, 0x2d, 0x49, 0x9c, 0xcd, 0x69, 0x49, 0xa6, 0x25, 0xd1, 0xa6,
+	0x42, 0x87, 0x57, 0xcb, 0x3b, 0x7c, 0x80, 0x1c, 0x3d, 0x96, 0xea, 0xee, 0x55, 0x70, 0x3c, 0x93,
+	0xe9, 0x64, 0x9c, 0xf0, 0xd8, 0xf9, 0xfa, 0x75, 0xde, 0x49, 0x93, 0x7a, 0xeb, 0xd7, 0x41, 0x29,
+	0x57, 0xe2, 0x78, 0x12, 0xab, 0x14, 0x06, 0x5e, 0xd0, 0x09, 0xae, 0xe2, 0x22, 0x4b, 0x14, 0x82,
+	0xbf, 0x43, 0xae, 0x68, 0xd8, 0x47, 0x32, 0xbd, 0x2b, 0x36, 0x9b, 0xaf, 0x0a, 0x5d, 0x9c, 0xd0,
+	0x46, 0xb6, 0x54, 0xf5, 0xb7, 0x8b, 0x51, 0xbb, 0x82, 0xd6, 0x2b, 0x36, 0xe2, 0xaf, 0x89, 0x9e,
+	0x8e, 0x9b, 0x16, 0xc1, 0x68, 0x4a, 0xf7, 0xf3, 0x56, 0x45, 0x1c, 0xd0, 0xe9, 0x7c, 0x54, 0x1c,
+	0xcb, 0xde, 0x41, 0x96, 0x21, 0x2d, 0x6d, 0x57, 0xf7, 0xfe, 0xcf, 0xa8, 0x34, 0xce, 0x08, 0x5a,
+	0xe7, 0xc0, 0xde, 0x40, 0xa6, 0x9d, 0xa8, 0x22, 0x60, 0x38, 0x65, 0x6f, 0x20, 0x57, 0x8e, 0x2a,
+	0x82, 0x73, 0xd6, 0xdd, 0x92, 0x87, 0x1d, 0xee, 0x76, 0x8a, 0x12, 0xc0, 0xe9, 0x94, 0xc3, 0xc5,
+	0xd0, 0xca, 0x52, 0xd5, 0x7e, 0xf8, 0x2b, 0xc8, 0xb2, 0xa9, 0x25, 0x5c, 0x6a, 0x51, 0xbe, 0x87,
+	0xf6, 0x8f, 0x8a, 0x3e, 0xf4, 0x09, 0x93, 0x96, 0xf3, 0xf7, 0x0d, 0x64, 0x1d, 0x31, 0xf7, 0xeb,
+	0x5a, 0x33, 0xfa, 0x53, 0x54, 0x1e, 0x98, 0xe5, 0x0a, 0xbc, 0x6c, 0x8c, 0xb9, 0x2c, 0x19, 0x0a,
+	0xf4, 0x4c, 0x05, 0x66, 0x4c, 0xd7, 0x8c, 0xdd, 0xee, 0x60, 0x71, 0x1d, 0x72, 0x16, 0x7b, 0x3d,
+	0x5a, 0x99, 0x54, 0xe6, 0xf5, 0x68, 0xd5, 0xb6, 0xfd, 0x4d, 0x64, 0xb9, 0x2c, 0x65, 0x32, 0x69,
+	0xc9, 0xff, 0x1e, 0x15, 0x83, 0xce, 0x1f, 0xa1, 0xc4, 0x55, 0xeb, 0xf5, 0x5d, 0x7b, 0xbd, 0xe6,
+	0xb9, 0xd4, 0x32, 0xfc, 0x4b, 0xb6, 0x62, 0x20, 0x68, 0x6a, 0x85, 0x85, 0x81, 0xe5, 0xcd, 0x30,
+	0xb9, 0xa7, 0x2f, 0xd4, 0x45, 0x29, 0xbb, 0x68, 0x1f, 0xc8, 0x8b, 0x48, 0x59, 0x02, 0x7b, 0xd2,
+	0xbd, 0x2c, 0x05, 0xf1, 0xba, 0x97, 0xa1, 0xdc, 0xdf, 0x94, 0xc9, 0x4a, 0x5e, 0x7f, 0x53, 0x1b,
+	0xdc, 0x86, 0x61, 0x70, 0xab, 0xd6, 0xcc, 0x7b, 0xae, 0x35, 0x53, 0xe0, 0x53, 0x0b, 0xf3, 0xdf,
+	0xc8, 0x11, 0xef, 0xdf, 0xef, 0x5c, 0xe9, 0x1c, 0x95, 0x03, 0x9c, 0x2b, 0xf9, 0x99, 0x79, 0x3a,
+	0x8c, 0x44, 0xb6, 0x8b, 0xcc, 0x5a, 0xc9, 0x00, 0x10, 0x84, 0xe0, 0xd4, 0x97, 0x27, 0x3b, 0xe3,
+	0x81, 0x72, 0x21, 0x4d, 0xd0, 0xca, 0x6a, 0xb9, 0xe0, 0xbf, 0x81, 0xac, 0x83, 0x4f, 0x41, 0x26,
+	0x2d, 0xf2, 0xd7, 0x3d, 0xe7, 0x5d, 0xc6, 0x23, 0x09, 0x0d, 0x91, 0x95, 0x42, 0x1a, 0x9d, 0x09,
+	0x22, 0x2f, 0xe2, 0x36, 0xbf, 0xb9, 0xdc, 0x9c, 0x88, 0xd5, 0x21, 0xb3, 0x02, 0x88, 0xe4, 0x93,
+	0xe3, 0x04, 0x1f, 0xd4, 0x26, 0xb4, 0x73, 0x2f, 0x1b, 0xb9, 0xdc, 0xcb, 0x95, 0x2b, 0xe5, 0xaa,
+	0xf8, 0x16, 0xb2, 0x4e, 0x54, 0x0e, 0x59, 0xb5, 0x32, 0x7a, 0x78, 0xce, 0x60, 0x01, 0xfa, 0xe4,
+	0x45, 0x63, 0x35, 0x6a, 0x40, 0x86, 0xcd, 0x3c, 0xa6, 0x06, 0xd5, 0x80, 0xe0, 0x96, 0x4c, 0x65,
+	0x70, 0xe6, 0x09, 0x2d, 0xe7, 0xf3, 0x84, 0x8c, 0x1c, 0x21, 0x3b, 0xcf, 0xa6, 0x56, 0xc8, 0xb3,
+	0xf9, 0xd0, 0xc3, 0x0b, 0x76, 0xde, 0xd7, 0x47, 0x94, 0x46, 0xf5, 0x31, 0x99, 0x84, 0xc4, 0xf2,
+	0x79, 0x54, 0x99, 0x9c, 0x54, 0x11, 0x90, 0xeb, 0x78, 0xde, 0xbc, 0x01, 0x90, 0x69, 0x7c, 0xcf,
+	0x38, 0xd3, 0xd6, 0xce, 0x9b, 0x94, 0x22, 0x23, 0xd0, 0xaa, 0xbc, 0xfc, 0x0a, 0x3e, 0x5c, 0x20,
+	0x31, 0x33, 0xcf, 0xea, 0xfb, 0x65, 0x94, 0x7e, 0x15, 0xc9, 0xb5, 0x24, 0xd3, 0xed, 0xb3, 0x9d,
+	0x5c, 0x29, 0x4d, 0x15, 0xb3, 0x30, 0xd6, 0x46, 0xf4, 0x15, 0x26, 0x8d, 0x93, 0x06, 0xf0, 0x25,
+	0xc9, 0xe2, 0x88, 0x25, 0xab, 0x93, 0x1d, 0x39, 0xbf, 0x1b, 0xd4, 0x04, 0x41, 0xcb, 0x6b, 0xe1,
+	0x7d, 0x63, 0x41, 0xab, 0x62, 0xf0, 0x45, 0xdc, 0xa6, 0x53, 0x93, 0x09, 0xbd, 0x88, 0x90, 0xb5,
+	0x88, 0x56, 0x30, 0xce, 0xc8, 0x12, 0x19, 0x63, 0x27, 0xa6, 0x09, 0x17, 0xf5, 0xa9, 0x41, 0x15,
+	0x7c, 0x09, 0x63, 0x78, 0xeb, 0x20, 0x5b, 0x16, 0x66, 0x14, 0x65, 0x66, 0x54, 0xbc, 0x97, 0xe8,
+	0xca, 0x57, 0x14, 0xfc, 0x9b, 0x9c, 0xc7, 0xb3, 0x74, 0x2a, 0xba, 0xa8, 0x59, 0xd9, 0x46, 0x16,
+	0x93, 0x54, 0x11, 0x05, 0xbf, 0x8e, 0xf0, 0x71, 0xf3, 0x66, 0xf3, 0xc6, 0x24, 0xcc, 0xdc, 0x40,
+	0xf1, 0xd2, 0x62, 0x13, 0x08, 0x73, 0xc9, 0x15, 0x9a, 0x29, 0x9a, 0x91, 0x54, 0xd9, 0xeb, 0xdf,
+	0xb4, 0xed, 0x75, 0x49, 0x87, 0x7a, 0xbd, 0xfe, 0x13, 0x72, 0x27, 0x39, 0x92, 0x4f, 0xaa, 0x24,
+	0x11, 0x64, 0x3d, 0x25, 0xd0, 0xb4, 0xeb, 0x53, 0x16, 0x87, 0xe9, 0x24, 0xce, 0xd2, 0x80, 0xaf,
+	0x3a, 0x13, 0xaa, 0x3d, 0xfb, 0x11, 0xd4, 0x01, 0xb2, 0xa8, 0xad, 0x30, 0x76, 0x2d, 0x97, 0xb3,
+	0xab, 0x37, 0x44, 0xf1, 0x50, 0x45, 0x96, 0x82, 0xb7, 0xf0, 0x62, 0xbe, 0x6d, 0xf2, 0x73, 0x78,
+	0x41, 0xdd, 0x1b, 0x5a, 0xc9, 0xce, 0x39, 0x28, 0xec, 0x34, 0x30, 0xc1, 0x32, 0x2a, 0xb1, 0xde,
+	0x2d, 0x18, 0x4c, 0xeb, 0x5b, 0x61, 0xca, 0x62, 0x30, 0x23, 0x2a, 0x76, 0x9b, 0x01, 0x82, 0x1e,
+	0x3e, 0xe2, 0x50, 0x8c, 0x91, 0xc0, 0x8c, 0xcc, 0x04, 0x66, 0xb5, 0x33, 0x18, 0x07, 0xc5, 0xac,
+	0x1c, 0xbc, 0x8d, 0x4f, 0xba, 0xc6, 0x03, 0x2e, 0x4a, 0xbb, 0x5b, 0x74, 0x4a, 0x9e, 0xc3, 0x75,
+	0x28, 0xcb, 0x00, 0x55, 0x65, 0x12, 0x2a, 0x27, 0x34, 0x1c, 0x68, 0xaf, 0xc4, 0x81, 0xae, 0x99,
+	0xab, 0x27, 0xf8, 0x22, 0x3e, 0x5d, 0x1c, 0x13, 0x8b, 0x85, 0xcf, 0xd8, 0x79, 0x40, 0x4f, 0x55,
+	0xf0, 0xa0, 0xea, 0xa8, 0xcc, 0xa0, 0x4d, 0xbc, 0x9c, 0xbb, 0xd3, 0x15, 0xbb, 0x09, 0xc7, 0x92,
+	0x0b, 0x76, 0xc3, 0x1d, 0x73, 0xcd, 0xba, 0x6a, 0xa8, 0x56, 0x27, 0xf8, 0x44, 0x29, 0x0d, 0x79,
+	0x16, 0x37, 0x7a, 0x03, 0xd8, 0x4c, 0x85, 0xc6, 0x8e, 0x99, 0x8d, 0x72, 0x44, 0x74, 0x3b, 0x82,
+	0x17, 0x53, 0xfc, 0x9b, 0x9c, 0xc5, 0x6d, 0x23, 0xed, 0x73, 0x57, 0x4d, 0x06, 0x1b, 0x18, 0x7c,
+	0x1d, 0xb9, 0x92, 0x11, 0x60, 0xe3, 0xd1, 0xee, 0x89, 0x3c, 0xe6, 0x1a, 0x90, 0x2c, 0x75, 0x4c,
+	0x3e, 0x37, 0xa9, 0x3a, 0x57, 0xfe, 0x96, 0x7d, 0xae, 0x2c, 0x76, 0xa6, 0x97, 0xf0, 0x3f, 0xa2,
+	0xea, 0x0c, 0x88, 0x47, 0x8a, 0xea, 0xef, 0xeb, 0x88, 0xac, 0xdc, 0x2c, 0x67, 0xfe, 0x7d, 0x64,
+	0xdd, 0xb6, 0x54, 0x31, 0xa7, 0xc5, 0xf8, 0x2b, 0x54, 0x96, 0xa6, 0xf1, 0x98, 0x04, 0xa8, 0x08,
+	0xbe, 0xfd, 0xb6, 0x10, 0xe0, 0x94, 0x71, 0xd6, 0xae, 0x3a, 0x85, 0x7c, 0x1f, 0xe1, 0xb6, 0x4c,
+	0xe9, 0x88, 0x45, 0xa2, 0xdb, 0x49, 0xf1, 0x0c, 0x55, 0x84, 0x31, 0xc4, 0x0e, 0xa9, 0x01, 0x46,
+	0x9a, 0xac, 0xe9, 0xbd, 0x77, 0x61, 0xff, 0x85, 0xa7, 0x78, 0x62, 0x43, 0x69, 0x53, 0x51, 0x20,
+	0x17, 0x70, 0x4b, 0x99, 0x3f, 0x95, 0x03, 0xea, 0x5b, 0x2b, 0x43, 0x22, 0xe5, 0xcb, 0x5c, 0x45,
+	0xaa, 0x23, 0x4e, 0x0d, 0x33, 0xe2, 0xf4, 0x01, 0x2a, 0x66, 0xbc, 0x3c, 0x92, 0x82, 0x0d, 0x17,
+	0xa0, 0x66, 0xb9, 0x00, 0x55, 0x87, 0xa2, 0xdf, 0xb1, 0x0f, 0x45, 0x79, 0x46, 0xb4, 0x4a, 0xdf,
+	0x47, 0xee, 0x14, 0x1c, 0x1d, 0x1c, 0x42, 0xe6, 0xeb, 0xe7, 0x45, 0x5c, 0xeb, 0xa7, 0xca, 0x13,
+	0x84, 0x4f, 0x60, 0x7b, 0x2c, 0x4e, 0x48, 0x22, 0x8a, 0x24, 0x4b, 0x55, 0x81, 0xb4, 0x6f, 0x23,
+	0x2b, 0xb1, 0xdf, 0xd5, 0xbd, 0x19, 0x48, 0x23, 0x0a, 0xd7, 0x65, 0x22, 0x2e, 0x3b, 0x89, 0x41,
+	0x91, 0x70, 0x5d, 0xb7, 0xa9, 0x12, 0x06, 0xeb, 0x34, 0x2b, 0x8b, 0x6d, 0x86, 0xc5, 0xb9, 0xe7,
+	0x28, 0x16, 0xac, 0x6a, 0xeb, 0x0b, 0xbe, 0xe3, 0xe1, 0x43, 0x39, 0xab, 0x55, 0xe1, 0x87, 0xe5,
+	0x8f, 0x4f, 0x9e, 0xe3, 0xf8, 0xa4, 0xa2, 0x2e, 0xdd, 0x2d, 0xb9, 0x3e, 0x54, 0x31, 0xc3, 0xf4,
+	0x53, 0x79, 0x78, 0x54, 0x45, 0x63, 0x3a, 0x34, 0xf2, 0x97, 0x9b, 0xe2, 0xb6, 0x12, 0x44, 0x9f,
+	0xe1, 0x28, 0x0d, 0x70, 0x27, 0xd9, 0xa3, 0xc7, 0x90, 0x64, 0x1f, 0x5c, 0xc5, 0xed, 0x6c, 0x56,
+	0xa9, 0xa5, 0xa8, 0x5d, 0x79, 0x54, 0xe1, 0xca, 0x7b, 0x96, 0x2b, 0x0f, 0xf9, 0xdc, 0x87, 0xf8,
+	0xe4, 0x32, 0x86, 0xd7, 0x78, 0x45, 0x80, 0xec, 0x57, 0x04, 0x01, 0x9e, 0xb7, 0x5e, 0x67, 0x4b,
+	0x75, 0x9b, 0x30, 0xb2, 0x82, 0x5b, 0x19, 0x6b, 0x32, 0x59, 0x78, 0x29, 0xbf, 0x10, 0xc4, 0x22,
+	0xce, 0x8a, 0xc1, 0x03, 0x84, 0x0f, 0x17, 0x56, 0xb9, 0xb9, 0xa7, 0xa1, 0xfd, 0xf7, 0xb4, 0x97,
+	0xf0, 0xbc, 0x59, 0x5b, 0x7a, 0xc4, 0x6a, 0x6b, 0x29, 0xce, 0x62, 0x6a, 0x91, 0x07, 0xff, 0x8e,
+	0x64, 0x7a, 0x80, 0xad, 0x57, 0x4b, 0x1a, 0x74, 0x20, 0x69, 0xc8, 0x05, 0x8c, 0xc5, 0x29, 0x2d,
+	0xfb, 0xff, 0x02, 0xcd, 0x7c, 0x4e, 0xd7, 0xd4, 0xa0, 0x24, 0x2f, 0xe3, 0xb6, 0xa5, 0x04, 0xa9,
+	0xbd, 0x72, 0x33, 0x68, 0x93, 0xdb, 0x93, 0xb3, 0xce, 0x0f, 0x37, 0x1a, 0x10, 0x8c, 0xf0, 0x51,
+	0x8b, 0x3c, 0x0b, 0x57, 0x57, 0x5b, 0x71, 0xcb, 0x2e, 0x7b, 0x07, 0xb6, 0xcb, 0xc1, 0x8f, 0x51,
+	0x69, 0x0e, 0xe1, 0xa3, 0x5e, 0xc0, 0x5b, 0x53, 0xaf, 0x56, 0x9c, 0x7a, 0x55, 0x27, 0x86, 0xdf,
+	0x45, 0x8e, 0x1b, 0xf8, 0x02, 0x67, 0x56, 0x80, 0xb7, 0x22, 0xcb, 0xb1, 0xc2, 0x22, 0xa9, 0x67,
+	0x39, 0x9e, 0xf1, 0x2c, 0xe7, 0x61, 0xa3, 0xbb, 0x37, 0xca, 0xe5, 0xf8, 0x0e, 0xb2, 0x52, 0x88,
+	0xca, 0x59, 0xb4, 0x2e, 0xe7, 0x8d, 0xc7, 0xa9, 0x8f, 0x3c, 0xab, 0x3b, 0x78, 0xce, 0x68, 0x46,
+	0xca, 0x67, 0x82, 0x82, 0x37, 0xf0, 0xb2, 0xe9, 0x3f, 0xe4, 0xfa, 0x74, 0xdd, 0x2f, 0xbe, 0x98,
+	0x6f, 0xd3, 0x7c, 0x4e, 0x98, 0x6b, 0xc0, 0xee, 0xeb, 0x4b, 0xf8, 0x88, 0x51, 0xcc, 0xe6, 0xf2,
+	0xa7, 0x6d, 0xdf, 0xfa, 0x4c, 0xf1, 0x5d, 0x45, 0xbe, 0x55, 0x41, 0x0f, 0x5b, 0xeb, 0x95, 0x58,
+	0xdd, 0xd0, 0xc0, 0x67, 0xf0, 0x93, 0x2c, 0x60, 0x59, 0xc8, 0x63, 0x2d, 0x04, 0x52, 0xec, 0x7f,
+	0x20, 0x68, 0x58, 0xef, 0xf5, 0x53, 0xf3, 0x3a, 0x2c, 0x2d, 0xbe, 0xd7, 0xaf, 0xe7, 0xdf, 0xeb,
+	0x57, 0x4d, 0xe3, 0x0f, 0x5c, 0x81, 0xca, 0x02, 0x7f, 0x56, 0x1a, 0x0c, 0xff, 0xdb, 0x02, 0x7e,
+	0xd6, 0xdf, 0xca, 0xce, 0xfa, 0x5b, 0xe4, 0x14, 0xf6, 0xfa, 0xa9, 0xb4, 0x4d, 0xb9, 0xff, 0x39,
+	0xf0, 0xfa, 0x29, 0xfc, 0xbd, 0x87, 0x7c, 0x0a, 0x57, 0xb3, 0x4f, 0xb6, 0x5b, 0xfd, 0x54, 0xac,
+	0xfb, 0x44, 0x3d, 0xcb, 0xe6, 0x85, 0xe5, 0x0d, 0x3c, 0x67, 0x80, 0x1d, 0x51, 0x97, 0xf3, 0xf6,
+	0x9b, 0xe0, 0x72, 0x1b, 0x62, 0xc4, 0x63, 0xde, 0xf1, 0xf0, 0x62, 0xfe, 0xcf, 0x35, 0x60, 0xe9,
+	0x31, 0x5e, 0x18, 0xc8, 0xe7, 0x84, 0xaa, 0x08, 0x86, 0x8c, 0x19, 0x57, 0x93, 0xf0, 0x94, 0x5d,
+	0x03, 0x60, 0xfe, 0x4d, 0xa6, 0x99, 0xa3, 0xc4, 0xbf, 0xc9, 0x29, 0x5c, 0x9b, 0xa6, 0x2a, 0xfe,
+	0x3d, 0x67, 0xc8, 0x48, 0x01, 0x0e, 0x0d, 0x6e, 0xef, 0xc4, 0x31, 0xe8, 0x96, 0xf1, 0x58, 0x62,
+	0x83, 0x6a, 0x00, 0x58, 0xb1, 0x69, 0xcc, 0x04, 0x72, 0x86, 0x23, 0xb3, 0x32, 0xc8, 0x9f, 0xc4,
+	0xdb, 0xf2, 0x91, 0x38, 0x7c, 0x42, 0xf7, 0x03, 0x96, 0xa4, 0x72, 0xa7, 0xe7, 0xdf, 0x70, 0x0c,
+	0xdb, 0xbe, 0xcb, 0xb6, 0xef, 0xad, 0x4e, 0xc6, 0xb7, 0x87, 0xd1, 0x76, 0x2a, 0xb7, 0x79, 0x1b,
+	0x08, 0xff, 0x2e, 0xe0, 0xc8, 0x99, 0x26, 0x9f, 0x92, 0xd2, 0x1a, 0xe7, 0xe4, 0xd2, 0x3f, 0x24,
+	0xd1, 0x94, 0x55, 0xa7, 0xb1, 0xef, 0xda, 0xa7, 0xb1, 0x62, 0x9f, 0x7a, 0x5e, 0x01, 0x4f, 0xc5,
+	0x7c, 0xed, 0xc7, 0xc0, 0xd3, 0xf7, 0x6c, 0x9e, 0x8a, 0x7d, 0x5a, 0xb7, 0x24, 0xae, 0x5c, 0xf1,
+	0x87, 0x9d, 0xfa, 0x27, 0x71, 0x8b, 0xef, 0xc9, 0xb0, 0xaa, 0xe4, 0x64, 0xd1, 0x00, 0xeb, 0x9f,
+	0x39, 0x90, 0xfe, 0xaf, 0x91, 0xaa, 0xc0, 0xf2, 0xef, 0xb9, 0x02, 0xcb, 0x16, 0x8b, 0x5a, 0x86,
+	0xd4, 0x95, 0xd5, 0x6e, 0x4f, 0x79, 0xcf, 0x98, 0xf2, 0x55, 0x9a, 0xfb, 0x7d, 0x5b, 0x73, 0xc5,
+	0x66, 0x75, 0xaf, 0xff, 0x83, 0xf6, 0x49, 0x9a, 0x2f, 0x7d, 0x24, 0x7c, 0x80, 0xf8, 0x8c, 0xb3,
+	0x62, 0x65, 0x66, 0x09, 0xc1, 0xf5, 0xb1, 0x71, 0x53, 0x05, 0xdf, 0x2b, 0xeb, 0xe5, 0x82, 0x7e,
+	0x5f, 0x08, 0x7a, 0xd6, 0x4e, 0x72, 0x70, 0x0b, 0xa2, 0x65, 0xfe, 0x6b, 0x54, 0xf9, 0x0a, 0x60,
+	0x3f, 0x1f, 0x25, 0xb6, 0xee, 0x35, 0x44, 0x09, 0xc6, 0x69, 0x10, 0x4f, 0xa6, 0x97, 0x86, 0x43,
+	0x19, 0x8f, 0x57, 0xc5, 0xaa, 0x9c, 0xcd, 0x3f, 0x10, 0xec, 0x07, 0x66, 0x66, 0xf6, 0x7e, 0xcc,
+	0xbf, 0x51, 0xf5, 0x40, 0xa1, 0xca, 0x7d, 0xf8, 0x43, 0xdb, 0x7d, 0x28, 0x6f, 0x44, 0xf7, 0x75,
+	0xbf, 0xe4, 0xb1, 0x83, 0xe1, 0xd5, 0x20, 0xd3, 0xab, 0xa9, 0xca, 0xa9, 0xf8, 0x23, 0xe4, 0xca,
+	0x47, 0xb1, 0xdb, 0xd5, 0x3d, 0xff, 0x2b, 0x3a, 0xe0, 0x63, 0x8a, 0x32, 0x56, 0x4a, 0x2f, 0xa0,
+	0xa4, 0xcb, 0x0b, 0xfb, 0x82, 0xd8, 0xe1, 0x6a, 0x54, 0x03, 0x56, 0x6e, 0x95, 0x0b, 0xf0, 0x03,
+	0x21, 0xc0, 0xb3, 0x5a, 0x7f, 0xfb, 0x73, 0xa7, 0x05, 0xfa, 0x00, 0xed, 0xff, 0xe4, 0xe3, 0xe1,
+	0x22, 0x79, 0x55, 0x17, 0xed, 0x7f, 0x6c, 0x5f, 0xb4, 0xef, 0xd7, 0xb1, 0x69, 0x84, 0x5c, 0x4f,
+	0x4e, 0x40, 0x99, 0x8c, 0xff, 0x4f, 0x95, 0x8c, 0xf9, 0xc9, 0x52, 0x95, 0xe9, 0xfb, 0x13, 0xdb,
+	0xf4, 0x39, 0x5a, 0x2d, 0xf4, 0x9a, 0x7b, 0xcf, 0xf2, 0x28, 0xbd, 0x7e, 0x58, 0xec, 0x35, 0xd7,
+	0xaa, 0xee, 0xf5, 0x57, 0x91, 0xf3, 0xb5, 0x0c, 0x79, 0xde, 0x7c, 0x21, 0x2b, 0x87, 0xc2, 0xf1,
+	0x14, 0xd4, 0x20, 0xaa, 0xe2, 0xe8, 0x87, 0x36, 0x47, 0x8e, 0x0e, 0x35, 0x47, 0x43, 0xc7, 0x2b,
+	0x1d, 0x67, 0x42, 0x4b, 0xc5, 0xb5, 0xee, 0x9f, 0xda, 0xd7, 0xba, 0x85, 0xf6, 0x74, 0x6f, 0x3f,
+	0x46, 0xfb, 0xbd, 0xfe, 0x79, 0xe8, 0xc5, 0x65, 0x3c, 0x7d, 0xae, 0x59, 0x4f, 0x9f, 0x57, 0xfa,
+	0xe5, 0x1c, 0xff, 0x99, 0xe0, 0xf8, 0xe9, 0xd2, 0x85, 0x65, 0xb2, 0x64, 0x19, 0x27, 0xe7, 0xbb,
+	0xa4, 0xb2, 0x37, 0xfa, 0x55, 0xc6, 0xe9, 0xcf, 0x6d, 0xe3, 0xe4, 0x6c, 0x57, 0xf7, 0xfc, 0x8b,
+	0xce, 0x67, 0x4f, 0x55, 0x93, 0xe0, 0x2f, 0xec, 0x49, 0xe0, 0xa8, 0xad, 0x5b, 0xff, 0x1a, 0x2a,
+	0x7b, 0x3c, 0x55, 0x70, 0x67, 0x16, 0x32, 0x77, 0x06, 0x92, 0x1f, 0x2a, 0x03, 0xbe, 0x7f, 0x69,
+	0x07, 0x7c, 0xdd, 0x1d, 0x68, 0x26, 0xfe, 0x0b, 0x55, 0xbc, 0xd2, 0x7a, 0x4c, 0x17, 0xff, 0x8b,
+	0xb8, 0xd6, 0x1b, 0x88, 0x00, 0x70, 0x9d, 0xc2, 0xa7, 0xfd, 0x9e, 0xa0, 0x91, 0x7b, 0x4f, 0x50,
+	0x95, 0xd5, 0xf5, 0x23, 0x3b, 0xab, 0xab, 0x54, 0x92, 0x4c, 0xe0, 0x9f, 0x0d, 0x00, 0x79, 0xf1,
+	0x7a, 0xda, 0xa4, 0x52, 0x00, 0x00,
 }
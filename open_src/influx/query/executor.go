@@ -11,6 +11,7 @@ Huawei Cloud Computing Technologies Co., Ltd.
 import (
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"runtime/debug"
 	"strconv"
@@ -70,6 +71,11 @@ func ErrMaxSelectPointsLimitExceeded(n, limit int) error {
 	return fmt.Errorf("max-select-point limit exceeed: (%d/%d)", n, limit)
 }
 
+// ErrMaxQueryMemoryLimitExceeded is an error when a query allocates more memory than allowed.
+func ErrMaxQueryMemoryLimitExceeded(n, limit int64) error {
+	return fmt.Errorf("max-query-memory limit exceeded: (%d/%d)", n, limit)
+}
+
 // ErrMaxConcurrentQueriesLimitExceeded is an error when a query cannot be run
 // because the maximum number of queries has been reached.
 func ErrMaxConcurrentQueriesLimitExceeded(n, limit int) error {
@@ -449,6 +455,9 @@ type Task struct {
 	monitorCh chan error
 	err       error
 	mu        sync.Mutex
+
+	progress    uint64 // math.Float64bits of the reported percentage
+	rowsScanned int64
 }
 
 // Monitor starts a new goroutine that will monitor a query. The function
@@ -456,7 +465,18 @@ type Task struct {
 // normally. If the function returns with an error and the query is still
 // running, the query will be terminated.
 func (q *Task) Monitor(fn MonitorFunc) {
-	go q.monitor(fn)
+	go q.monitor(SafeMonitor(fn))
+}
+
+// Report records the progress of the query, see ProgressReporter.
+func (q *Task) Report(pct float64, rowsScanned int64) {
+	atomic.StoreUint64(&q.progress, math.Float64bits(pct))
+	atomic.StoreInt64(&q.rowsScanned, rowsScanned)
+}
+
+// Progress returns the last progress reported for the query.
+func (q *Task) Progress() (float64, int64) {
+	return math.Float64frombits(atomic.LoadUint64(&q.progress)), atomic.LoadInt64(&q.rowsScanned)
 }
 
 // Error returns any asynchronous error that may have occurred while executing
@@ -12,6 +12,13 @@ Huawei Cloud Computing Technologies Co., Ltd.
 
 import (
 	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/logger"
+	"go.uber.org/zap"
 )
 
 // MonitorFunc is a function that will be called to check if a query
@@ -26,12 +33,196 @@ type Monitor interface {
 	// will be passed in a channel to signal when the query has been finished
 	// normally. If the function returns with an error and the query is still
 	// running, the query will be terminated.
+	// Implementations should wrap fn with SafeMonitor so that a panic only
+	// fails the query instead of crashing the server.
 	Monitor(fn MonitorFunc)
 }
 
+// ProgressReporter is an optional extension of Monitor which receives the
+// progress of a query. Operators such as table scans look it up with
+// ProgressReporterFromContext and call Report periodically.
+type ProgressReporter interface {
+	Monitor
+	// Report records the estimated percentage (0-100) of the query that has
+	// been done and the number of rows scanned so far.
+	Report(pct float64, rowsScanned int64)
+}
+
+// ProgressReporterFromContext returns the ProgressReporter embedded within
+// the Context if the Monitor of the Context supports it.
+func ProgressReporterFromContext(ctx context.Context) ProgressReporter {
+	r, _ := MonitorFromContext(ctx).(ProgressReporter)
+	return r
+}
+
+// SafeMonitor returns a MonitorFunc that recovers a panic of fn, logs its
+// stack trace and returns it as an error.
+func SafeMonitor(fn MonitorFunc) MonitorFunc {
+	return func(closing <-chan struct{}) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.GetLogger().Error("query monitor panic",
+					zap.Any("panic", r), zap.String("stack", string(debug.Stack())))
+				err = fmt.Errorf("query monitor panic: %v", r)
+			}
+		}()
+		return fn(closing)
+	}
+}
+
+// NewMonitorContext returns a new Context with the Monitor embedded.
+func NewMonitorContext(ctx context.Context, m Monitor) context.Context {
+	return context.WithValue(ctx, monitorContextKey{}, m)
+}
+
 // MonitorFromContext returns a Monitor embedded within the Context
 // if one exists.
 func MonitorFromContext(ctx context.Context) Monitor {
 	v, _ := ctx.Value(monitorContextKey{}).(Monitor)
 	return v
 }
+
+// DefaultMemoryMonitorInterval is the interval used by MemoryMonitor to sample the memory usage of a query.
+const DefaultMemoryMonitorInterval = 100 * time.Millisecond
+
+// MemoryMonitor returns a MonitorFunc that samples usage every DefaultMemoryMonitorInterval
+// and interrupts the query once it exceeds limitBytes.
+func MemoryMonitor(limitBytes int64, usage func() int64) MonitorFunc {
+	return MemoryMonitorWithInterval(limitBytes, DefaultMemoryMonitorInterval, usage)
+}
+
+// MemoryMonitorWithInterval returns a MonitorFunc that samples usage, the bytes allocated
+// by the query, every interval and interrupts the query once it exceeds limitBytes.
+func MemoryMonitorWithInterval(limitBytes int64, interval time.Duration, usage func() int64) MonitorFunc {
+	return func(closing <-chan struct{}) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if n := usage(); n > limitBytes {
+					return ErrMaxQueryMemoryLimitExceeded(n, limitBytes)
+				}
+			case <-closing:
+				return nil
+			}
+		}
+	}
+}
+
+// DeadlineMonitor returns a MonitorFunc that interrupts the query with ctx.Err()
+// once ctx is done. Registering it through Monitor.Monitor cancels the query
+// automatically when the deadline of ctx passes. ctx done after the query has
+// finished is not reported.
+func DeadlineMonitor(ctx context.Context) MonitorFunc {
+	return func(closing <-chan struct{}) error {
+		select {
+		case <-ctx.Done():
+			select {
+			case <-closing:
+				return nil
+			default:
+				return ctx.Err()
+			}
+		case <-closing:
+			return nil
+		}
+	}
+}
+
+// MultiMonitor fans a MonitorFunc out to several monitors. The function runs
+// once and is handed a done-channel that is closed as soon as any underlying
+// monitor reports the query as finished; its error is returned to every monitor.
+type MultiMonitor []Monitor
+
+func (mm MultiMonitor) Monitor(fn MonitorFunc) {
+	if len(mm) == 0 {
+		return
+	}
+
+	s := &sharedMonitor{
+		fn:       fn,
+		done:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+	for _, m := range mm {
+		m.Monitor(s.monitor)
+	}
+}
+
+type sharedMonitor struct {
+	fn       MonitorFunc
+	err      error
+	done     chan struct{}
+	finished chan struct{}
+	start    sync.Once
+	stop     sync.Once
+}
+
+func (s *sharedMonitor) monitor(closing <-chan struct{}) error {
+	s.start.Do(func() {
+		go func() {
+			s.err = SafeMonitor(s.fn)(s.done)
+			close(s.finished)
+		}()
+	})
+
+	select {
+	case <-s.finished:
+		return s.err
+	case <-closing:
+		s.stop.Do(func() {
+			close(s.done)
+		})
+		return nil
+	}
+}
+
+// CombineMonitors returns a MonitorFunc that runs every fn in its own goroutine
+// and returns the first non-nil error. The goroutines are handed a done-channel
+// that is closed when the query finishes or one of them fails, so none of them leak.
+func CombineMonitors(fns ...MonitorFunc) MonitorFunc {
+	return func(closing <-chan struct{}) error {
+		done := make(chan struct{})
+		defer close(done)
+
+		errCh := make(chan error, len(fns))
+		for _, fn := range fns {
+			go func(fn MonitorFunc) {
+				errCh <- fn(done)
+			}(SafeMonitor(fn))
+		}
+
+		for range fns {
+			select {
+			case err := <-errCh:
+				if err != nil {
+					return err
+				}
+			case <-closing:
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
+// ShardHealthMonitor returns a MonitorFunc that calls check every interval
+// and interrupts the query with its error, so that a query reading from a
+// shard which becomes unavailable fails fast instead of waiting for an I/O error.
+func ShardHealthMonitor(check func() error, interval time.Duration) MonitorFunc {
+	return func(closing <-chan struct{}) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := check(); err != nil {
+					return err
+				}
+			case <-closing:
+				return nil
+			}
+		}
+	}
+}
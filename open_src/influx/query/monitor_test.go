@@ -0,0 +1,219 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryMonitorWithInterval(t *testing.T) {
+	var usage int64
+	fn := query.MemoryMonitorWithInterval(1024, time.Millisecond, func() int64 {
+		return atomic.AddInt64(&usage, 256)
+	})
+	err := fn(make(chan struct{}))
+	require.EqualError(t, err, query.ErrMaxQueryMemoryLimitExceeded(1280, 1024).Error())
+
+	// the monitor stops when the query finishes
+	closing := make(chan struct{})
+	close(closing)
+	fn = query.MemoryMonitorWithInterval(1024, time.Hour, func() int64 { return 0 })
+	require.NoError(t, fn(closing))
+}
+
+func TestDeadlineMonitor(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := query.DeadlineMonitor(ctx)(make(chan struct{}))
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	closing := make(chan struct{})
+	close(closing)
+	require.NoError(t, query.DeadlineMonitor(context.Background())(closing))
+
+	// ctx canceled after the query has finished
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, query.DeadlineMonitor(ctx)(closing))
+}
+
+func TestMemoryMonitor(t *testing.T) {
+	var usage int64
+	go func() {
+		for i := 0; i < 4; i++ {
+			time.Sleep(query.DefaultMemoryMonitorInterval / 2)
+			atomic.AddInt64(&usage, 512)
+		}
+	}()
+
+	fn := query.MemoryMonitor(1024, func() int64 {
+		return atomic.LoadInt64(&usage)
+	})
+	err := fn(make(chan struct{}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "max-query-memory limit exceeded")
+	require.Greater(t, atomic.LoadInt64(&usage), int64(1024))
+}
+
+type mockMonitor struct {
+	closing chan struct{}
+	errCh   chan error
+}
+
+func newMockMonitor() *mockMonitor {
+	return &mockMonitor{closing: make(chan struct{}), errCh: make(chan error, 1)}
+}
+
+func (m *mockMonitor) Monitor(fn query.MonitorFunc) {
+	go func() {
+		m.errCh <- fn(m.closing)
+	}()
+}
+
+func TestMultiMonitor(t *testing.T) {
+	m1, m2 := newMockMonitor(), newMockMonitor()
+	fired := errors.New("fired")
+	trigger := make(chan struct{})
+	var calls int64
+	query.MultiMonitor{m1, m2}.Monitor(func(done <-chan struct{}) error {
+		atomic.AddInt64(&calls, 1)
+		select {
+		case <-trigger:
+			return fired
+		case <-done:
+			return nil
+		}
+	})
+	close(trigger)
+	require.Equal(t, fired, <-m1.errCh)
+	require.Equal(t, fired, <-m2.errCh)
+	require.Equal(t, int64(1), atomic.LoadInt64(&calls))
+
+	// finishing the query through one monitor stops the function and the others
+	m1, m2 = newMockMonitor(), newMockMonitor()
+	stopped := make(chan struct{})
+	query.MultiMonitor{m1, m2}.Monitor(func(done <-chan struct{}) error {
+		<-done
+		close(stopped)
+		return nil
+	})
+	close(m1.closing)
+	require.NoError(t, <-m1.errCh)
+	<-stopped
+	require.NoError(t, <-m2.errCh)
+}
+
+func TestNewMonitorContext(t *testing.T) {
+	require.Nil(t, query.MonitorFromContext(context.Background()))
+
+	m := newMockMonitor()
+	ctx := query.NewMonitorContext(context.Background(), m)
+	require.Equal(t, m, query.MonitorFromContext(ctx))
+}
+
+func TestCombineMonitors(t *testing.T) {
+	var stopped int64
+	idle := func(done <-chan struct{}) error {
+		<-done
+		atomic.AddInt64(&stopped, 1)
+		return nil
+	}
+	healthy := func(done <-chan struct{}) error {
+		return nil
+	}
+	fired := errors.New("fired")
+	failing := func(done <-chan struct{}) error {
+		time.Sleep(10 * time.Millisecond)
+		return fired
+	}
+
+	fn := query.CombineMonitors(idle, healthy, failing, idle)
+	require.Equal(t, fired, fn(make(chan struct{})))
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&stopped) == 2
+	}, time.Second, time.Millisecond)
+
+	// all monitors stop when the query finishes
+	atomic.StoreInt64(&stopped, 0)
+	closing := make(chan struct{})
+	close(closing)
+	require.NoError(t, query.CombineMonitors(idle, idle)(closing))
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&stopped) == 2
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, query.CombineMonitors(healthy, healthy)(make(chan struct{})))
+}
+
+func TestSafeMonitor(t *testing.T) {
+	fn := query.SafeMonitor(func(closing <-chan struct{}) error {
+		panic("monitor failed")
+	})
+	require.EqualError(t, fn(make(chan struct{})), "query monitor panic: monitor failed")
+
+	fired := errors.New("fired")
+	fn = query.SafeMonitor(func(closing <-chan struct{}) error {
+		return fired
+	})
+	require.Equal(t, fired, fn(make(chan struct{})))
+
+	// panics in combined monitors fail the query as well
+	fn = query.CombineMonitors(func(closing <-chan struct{}) error {
+		var m map[string]int
+		m["a"]++
+		return nil
+	})
+	err := fn(make(chan struct{}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "query monitor panic")
+}
+
+func TestProgressReporter(t *testing.T) {
+	// monitors without progress support are not reporters
+	ctx := query.NewMonitorContext(context.Background(), newMockMonitor())
+	require.Nil(t, query.ProgressReporterFromContext(ctx))
+	require.Nil(t, query.ProgressReporterFromContext(context.Background()))
+
+	tm := query.NewTaskManager()
+	defer tm.Close()
+	q, err := influxql.ParseQuery("SELECT * FROM cpu")
+	require.NoError(t, err)
+	qCtx, detach, err := tm.AttachQuery(q, query.ExecutionOptions{Database: "db0"}, nil, nil)
+	require.NoError(t, err)
+	defer detach()
+
+	reporter := query.ProgressReporterFromContext(qCtx)
+	require.NotNil(t, reporter)
+	reporter.Report(42.5, 1000)
+
+	queries := tm.Queries()
+	require.Equal(t, 1, len(queries))
+	require.Equal(t, 42.5, queries[0].Progress)
+	require.Equal(t, int64(1000), queries[0].RowsScanned)
+}
+
+func TestShardHealthMonitor(t *testing.T) {
+	var checks int64
+	offline := errors.New("shard 1 is offline")
+	fn := query.ShardHealthMonitor(func() error {
+		if atomic.AddInt64(&checks, 1) < 3 {
+			return nil
+		}
+		return offline
+	}, time.Millisecond)
+	require.Equal(t, offline, fn(make(chan struct{})))
+	require.Equal(t, int64(3), atomic.LoadInt64(&checks))
+
+	closing := make(chan struct{})
+	close(closing)
+	fn = query.ShardHealthMonitor(func() error {
+		return offline
+	}, time.Hour)
+	require.NoError(t, fn(closing))
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// QueryRegistry keeps the cancel functions of running queries so that
+// they can be listed and killed by query ID.
+type QueryRegistry struct {
+	mu      sync.Mutex
+	cancels map[uint64]context.CancelFunc
+}
+
+func NewQueryRegistry() *QueryRegistry {
+	return &QueryRegistry{
+		cancels: make(map[uint64]context.CancelFunc),
+	}
+}
+
+// Register records the cancel function of query id, a previous registration of id is replaced.
+func (r *QueryRegistry) Register(id uint64, cancel context.CancelFunc) {
+	r.mu.Lock()
+	r.cancels[id] = cancel
+	r.mu.Unlock()
+}
+
+// Unregister removes query id without cancelling it.
+func (r *QueryRegistry) Unregister(id uint64) {
+	r.mu.Lock()
+	delete(r.cancels, id)
+	r.mu.Unlock()
+}
+
+// Kill cancels query id and removes it, it returns false if the query is not registered.
+func (r *QueryRegistry) Kill(id uint64) bool {
+	r.mu.Lock()
+	cancel, ok := r.cancels[id]
+	delete(r.cancels, id)
+	r.mu.Unlock()
+
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// List returns the sorted IDs of the registered queries.
+func (r *QueryRegistry) List() []uint64 {
+	r.mu.Lock()
+	ids := make([]uint64, 0, len(r.cancels))
+	for id := range r.cancels {
+		ids = append(ids, id)
+	}
+	r.mu.Unlock()
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// Attach registers query id with a cancelable child of ctx. If ctx carries a
+// Monitor, the query is aborted through it once killed. The returned function
+// must be called when the query finishes, the cancellation it makes is not
+// reported to the Monitor as a kill.
+func (r *QueryRegistry) Attach(ctx context.Context, id uint64) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	r.Register(id, cancel)
+
+	var finished int32
+	if m := MonitorFromContext(ctx); m != nil {
+		deadline := DeadlineMonitor(ctx)
+		m.Monitor(func(closing <-chan struct{}) error {
+			err := deadline(closing)
+			if atomic.LoadInt32(&finished) == 1 {
+				return nil
+			}
+			return err
+		})
+	}
+
+	return ctx, func() {
+		atomic.StoreInt32(&finished, 1)
+		r.Unregister(id)
+		cancel()
+	}
+}
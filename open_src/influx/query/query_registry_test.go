@@ -0,0 +1,97 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openGemini/openGemini/open_src/influx/influxql"
+	"github.com/openGemini/openGemini/open_src/influx/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryRegistry(t *testing.T) {
+	r := query.NewQueryRegistry()
+	require.Equal(t, []uint64{}, r.List())
+
+	var killed []uint64
+	for _, id := range []uint64{3, 1, 2} {
+		id := id
+		r.Register(id, func() {
+			killed = append(killed, id)
+		})
+	}
+	require.Equal(t, []uint64{1, 2, 3}, r.List())
+
+	require.True(t, r.Kill(2))
+	require.Equal(t, []uint64{2}, killed)
+	require.Equal(t, []uint64{1, 3}, r.List())
+
+	// double kill
+	require.False(t, r.Kill(2))
+	require.False(t, r.Kill(4))
+	require.Equal(t, []uint64{2}, killed)
+
+	r.Unregister(1)
+	require.Equal(t, []uint64{3}, r.List())
+	require.Equal(t, []uint64{2}, killed)
+}
+
+func TestQueryRegistry_Attach(t *testing.T) {
+	r := query.NewQueryRegistry()
+	m := newMockMonitor()
+	ctx, done := r.Attach(query.NewMonitorContext(context.Background(), m), 1)
+	require.Equal(t, []uint64{1}, r.List())
+
+	require.True(t, r.Kill(1))
+	<-ctx.Done()
+	// the monitor aborts the query
+	require.Equal(t, context.Canceled, <-m.errCh)
+
+	done()
+	require.Equal(t, []uint64{}, r.List())
+
+	_, done = r.Attach(context.Background(), 2)
+	require.Equal(t, []uint64{2}, r.List())
+	done()
+	require.False(t, r.Kill(2))
+}
+
+func TestQueryRegistry_AttachFinished(t *testing.T) {
+	r := query.NewQueryRegistry()
+	m := newMockMonitor()
+	ctx, done := r.Attach(query.NewMonitorContext(context.Background(), m), 1)
+
+	// finishing the query cancels ctx, which is not a kill
+	done()
+	<-ctx.Done()
+	require.NoError(t, <-m.errCh)
+	require.Equal(t, []uint64{}, r.List())
+}
+
+func TestTaskManager_QueryContext(t *testing.T) {
+	tm := query.NewTaskManager()
+
+	ctx, detach, err := tm.AttachQuery(&influxql.Query{}, query.ExecutionOptions{}, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, ctx.Context.Err())
+
+	// killing the query cancels its context
+	require.NoError(t, tm.KillQuery(ctx.QueryID))
+	<-ctx.Context.Done()
+	require.Equal(t, query.ErrAlreadyKilled, tm.KillQuery(ctx.QueryID))
+	detach()
+
+	// the context of a finished query is released
+	ctx, detach, err = tm.AttachQuery(&influxql.Query{}, query.ExecutionOptions{}, nil, nil)
+	require.NoError(t, err)
+	detach()
+	<-ctx.Context.Done()
+	require.Error(t, tm.KillQuery(ctx.QueryID))
+
+	// closing the manager cancels the running queries
+	ctx, detach, err = tm.AttachQuery(&influxql.Query{}, query.ExecutionOptions{}, nil, nil)
+	require.NoError(t, err)
+	defer detach()
+	require.NoError(t, tm.Close())
+	<-ctx.Context.Done()
+}
@@ -84,6 +84,7 @@ type TaskManager struct {
 
 	// Used for managing and tracking running queries.
 	queries  map[uint64]*Task
+	registry *QueryRegistry
 	nextID   uint64
 	mu       sync.RWMutex
 	shutdown bool
@@ -95,6 +96,7 @@ func NewTaskManager() *TaskManager {
 		QueryTimeout: DefaultQueryTimeout,
 		Logger:       zap.NewNop(),
 		queries:      make(map[uint64]*Task),
+		registry:     NewQueryRegistry(),
 		nextID:       1,
 	}
 }
@@ -217,15 +219,20 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 	}
 	t.nextID++
 
-	qCtx := context.Background()
+	// the context is canceled when the query is killed, so that the operations running with it stop as well
+	qCtx := NewMonitorContext(context.WithValue(context.Background(), QueryDurationKey, qStat), query)
+	qCtx, finish := t.registry.Attach(qCtx, qid)
 	ctx := &ExecutionContext{
-		Context:          context.WithValue(qCtx, QueryDurationKey, qStat),
+		Context:          qCtx,
 		QueryID:          qid,
 		task:             query,
 		ExecutionOptions: opt,
 	}
 	ctx.watch()
-	return ctx, func() { t.DetachQuery(qid) }, nil
+	return ctx, func() {
+		t.DetachQuery(qid)
+		finish()
+	}, nil
 }
 
 // KillQuery enters a query into the killed state and closes the channel
@@ -239,7 +246,11 @@ func (t *TaskManager) KillQuery(qid uint64) error {
 	if query == nil {
 		return fmt.Errorf("no such query id: %d", qid)
 	}
-	return query.kill()
+	if err := query.kill(); err != nil {
+		return err
+	}
+	t.registry.Kill(qid)
+	return nil
 }
 
 // DetachQuery removes a query from the query table. If the query is not in the
@@ -265,6 +276,9 @@ type QueryInfo struct {
 	Database string        `json:"database"`
 	Duration time.Duration `json:"duration"`
 	Status   TaskStatus    `json:"status"`
+
+	Progress    float64 `json:"progress"`
+	RowsScanned int64   `json:"rows_scanned"`
 }
 
 // Queries returns a list of all running queries with information about them.
@@ -275,12 +289,15 @@ func (t *TaskManager) Queries() []QueryInfo {
 	now := time.Now()
 	queries := make([]QueryInfo, 0, len(t.queries))
 	for id, qi := range t.queries {
+		progress, rowsScanned := qi.Progress()
 		queries = append(queries, QueryInfo{
-			ID:       id,
-			Query:    qi.query,
-			Database: qi.database,
-			Duration: now.Sub(qi.startTime),
-			Status:   qi.status,
+			ID:          id,
+			Query:       qi.query,
+			Database:    qi.database,
+			Duration:    now.Sub(qi.startTime),
+			Status:      qi.status,
+			Progress:    progress,
+			RowsScanned: rowsScanned,
 		})
 	}
 	return queries
@@ -318,9 +335,10 @@ func (t *TaskManager) Close() error {
 	defer t.mu.Unlock()
 
 	t.shutdown = true
-	for _, query := range t.queries {
+	for qid, query := range t.queries {
 		query.setError(ErrQueryEngineShutdown)
 		query.close()
+		t.registry.Kill(qid)
 	}
 	t.queries = nil
 	return nil